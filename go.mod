@@ -8,6 +8,7 @@ require (
 	github.com/Knetic/govaluate v3.0.0+incompatible
 	github.com/golang/protobuf v1.5.3
 	github.com/nats-io/nats.go v1.31.0
+	github.com/nats-io/nuid v1.0.1
 	github.com/neo4j/neo4j-go-driver/v4 v4.4.1
 	google.golang.org/grpc v1.57.0
 	google.golang.org/protobuf v1.30.0
@@ -16,7 +17,6 @@ require (
 require (
 	github.com/klauspost/compress v1.17.0 // indirect
 	github.com/nats-io/nkeys v0.4.5 // indirect
-	github.com/nats-io/nuid v1.0.1 // indirect
 	golang.org/x/crypto v0.9.0 // indirect
 	golang.org/x/net v0.10.0 // indirect
 	golang.org/x/sys v0.8.0 // indirect
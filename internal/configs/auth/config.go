@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"os"
+	"strings"
+)
+
+type Config interface {
+	// Enabled reports whether administration callers are authenticated and authorized
+	Enabled() bool
+	// TokensFile returns the path of the file with the hashes of accepted bearer tokens
+	TokensFile() string
+	// Superusers returns the callers allowed every administration operation
+	Superusers() []string
+}
+
+type config struct {
+	enabled    bool
+	tokensFile string
+	superusers []string
+}
+
+func NewConfig() Config {
+	return config{
+		enabled:    os.Getenv("OORT_AUTH_ENABLED") == "true",
+		tokensFile: os.Getenv("OORT_AUTH_TOKENS_FILE"),
+		superusers: split(os.Getenv("OORT_AUTH_SUPERUSERS")),
+	}
+}
+
+func (c config) Enabled() bool {
+	return c.enabled
+}
+
+func (c config) TokensFile() string {
+	return c.tokensFile
+}
+
+func (c config) Superusers() []string {
+	return c.superusers
+}
+
+func split(value string) []string {
+	values := make([]string, 0)
+	for _, s := range strings.Split(value, ",") {
+		if strings.TrimSpace(s) != "" {
+			values = append(values, strings.TrimSpace(s))
+		}
+	}
+	return values
+}
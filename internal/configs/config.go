@@ -1,6 +1,8 @@
 package configs
 
 import (
+	"github.com/c12s/oort/internal/configs/auth"
+	"github.com/c12s/oort/internal/configs/decisions"
 	"github.com/c12s/oort/internal/configs/nats"
 	"github.com/c12s/oort/internal/configs/neo4j"
 	"github.com/c12s/oort/internal/configs/server"
@@ -10,19 +12,25 @@ type Config interface {
 	Neo4j() neo4j.Config
 	Nats() nats.Config
 	Server() server.Config
+	Decisions() decisions.Config
+	Auth() auth.Config
 }
 
 type config struct {
-	neo4j  neo4j.Config
-	nats   nats.Config
-	server server.Config
+	neo4j     neo4j.Config
+	nats      nats.Config
+	server    server.Config
+	decisions decisions.Config
+	auth      auth.Config
 }
 
 func NewConfig() (Config, error) {
 	return &config{
-		neo4j:  neo4j.NewConfig(),
-		nats:   nats.NewConfig(),
-		server: server.NewConfig(),
+		neo4j:     neo4j.NewConfig(),
+		nats:      nats.NewConfig(),
+		server:    server.NewConfig(),
+		decisions: decisions.NewConfig(),
+		auth:      auth.NewConfig(),
 	}, nil
 }
 
@@ -37,3 +45,11 @@ func (c config) Nats() nats.Config {
 func (c config) Server() server.Config {
 	return c.server
 }
+
+func (c config) Decisions() decisions.Config {
+	return c.decisions
+}
+
+func (c config) Auth() auth.Config {
+	return c.auth
+}
@@ -0,0 +1,80 @@
+package decisions
+
+import (
+	"log"
+	"os"
+	"strconv"
+)
+
+type Config interface {
+	NatsEnabled() bool
+	// empty if decisions aren't logged to a file
+	FilePath() string
+	FileMaxSize() int64
+	FileMaxBackups() int
+	SampleRate() float64
+}
+
+type config struct {
+	natsEnabled    bool
+	filePath       string
+	fileMaxSize    int64
+	fileMaxBackups int
+	sampleRate     float64
+}
+
+func NewConfig() Config {
+	return config{
+		natsEnabled:    os.Getenv("DECISION_LOG_NATS_ENABLED") == "true",
+		filePath:       os.Getenv("DECISION_LOG_FILE"),
+		fileMaxSize:    int64(positiveInt("DECISION_LOG_FILE_MAX_SIZE_MB", 100)) * 1024 * 1024,
+		fileMaxBackups: positiveInt("DECISION_LOG_FILE_MAX_BACKUPS", 5),
+		sampleRate:     sampleRate(),
+	}
+}
+
+func (c config) NatsEnabled() bool {
+	return c.natsEnabled
+}
+
+func (c config) FilePath() string {
+	return c.filePath
+}
+
+func (c config) FileMaxSize() int64 {
+	return c.fileMaxSize
+}
+
+func (c config) FileMaxBackups() int {
+	return c.fileMaxBackups
+}
+
+func (c config) SampleRate() float64 {
+	return c.sampleRate
+}
+
+func positiveInt(key string, defaultValue int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Printf("invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return n
+}
+
+func sampleRate() float64 {
+	value, ok := os.LookupEnv("DECISION_LOG_SAMPLE_RATE")
+	if !ok {
+		return 1
+	}
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		log.Printf("invalid decision log sample rate %q, logging all decisions", value)
+		return 1
+	}
+	return rate
+}
@@ -2,29 +2,213 @@ package nats
 
 import (
 	"fmt"
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config interface {
 	Uri() string
+	// CredsFile returns the user credentials file, it takes precedence over the nkey seed file
+	CredsFile() string
+	NkeySeedFile() string
+	TLS() TLSConfig
+	JetStream() JetStreamConfig
+}
+
+type TLSConfig interface {
+	Enabled() bool
+	// CAFile returns the CA that signed the server certificate, system CAs are trusted if it isn't set
+	CAFile() string
+	// CertFile and KeyFile hold the client certificate, if the server verifies clients
+	CertFile() string
+	KeyFile() string
+}
+
+type JetStreamConfig interface {
+	Enabled() bool
+	Stream() string
+	Durable() string
+	MaxDeliver() int
+	BackOff() []time.Duration
+	DeadLetterSubject() string
+	AckWait() time.Duration
+	MaxAge() time.Duration
 }
 
 type config struct {
-	hostname string
-	port     string
-	username string
-	password string
+	hostname  string
+	port      string
+	username  string
+	password  string
+	credsFile string
+	nkeySeed  string
+	tls       tlsConfig
+	jetStream jetStreamConfig
+}
+
+type tlsConfig struct {
+	enabled  bool
+	caFile   string
+	certFile string
+	keyFile  string
+}
+
+type jetStreamConfig struct {
+	enabled           bool
+	stream            string
+	durable           string
+	maxDeliver        int
+	backOff           []time.Duration
+	deadLetterSubject string
+	ackWait           time.Duration
+	maxAge            time.Duration
 }
 
 func NewConfig() Config {
 	return config{
-		hostname: os.Getenv("NATS_HOSTNAME"),
-		port:     os.Getenv("NATS_PORT"),
-		username: os.Getenv("NATS_USERNAME"),
-		password: os.Getenv("NATS_PASSWORD"),
+		hostname:  os.Getenv("NATS_HOSTNAME"),
+		port:      os.Getenv("NATS_PORT"),
+		username:  os.Getenv("NATS_USERNAME"),
+		password:  os.Getenv("NATS_PASSWORD"),
+		credsFile: os.Getenv("NATS_CREDS_FILE"),
+		nkeySeed:  os.Getenv("NATS_NKEY_SEED_FILE"),
+		tls: tlsConfig{
+			enabled:  os.Getenv("NATS_TLS_ENABLED") == "true",
+			caFile:   os.Getenv("NATS_TLS_CA_FILE"),
+			certFile: os.Getenv("NATS_TLS_CERT_FILE"),
+			keyFile:  os.Getenv("NATS_TLS_KEY_FILE"),
+		},
+		jetStream: jetStreamConfig{
+			enabled:           os.Getenv("NATS_JETSTREAM_ENABLED") == "true",
+			stream:            getenv("NATS_JETSTREAM_STREAM", "OORT_ADMINISTRATION"),
+			durable:           getenv("NATS_JETSTREAM_DURABLE", "oort"),
+			maxDeliver:        maxDeliver(getenv("NATS_JETSTREAM_MAX_DELIVER", "5")),
+			backOff:           backOff(getenv("NATS_JETSTREAM_BACKOFF", "1s,5s,30s")),
+			deadLetterSubject: getenv("NATS_JETSTREAM_DEAD_LETTER_SUBJECT", "oort.administration.deadletter"),
+			ackWait:           duration(getenv("NATS_JETSTREAM_ACK_WAIT", "30s"), 30*time.Second),
+			maxAge:            duration(getenv("NATS_JETSTREAM_MAX_AGE", "24h"), 24*time.Hour),
+		},
 	}
 }
 
+// Uri embeds the username and password only if there is one, so that they don't
+// override credentials and nkey authentication
 func (c config) Uri() string {
-	return fmt.Sprintf("nats://%s:%s@%s:%s", c.username, c.password, c.hostname, c.port)
+	scheme := "nats"
+	if c.tls.enabled {
+		scheme = "tls"
+	}
+	if c.username == "" {
+		return fmt.Sprintf("%s://%s:%s", scheme, c.hostname, c.port)
+	}
+	return fmt.Sprintf("%s://%s:%s@%s:%s", scheme, c.username, c.password, c.hostname, c.port)
+}
+
+func (c config) CredsFile() string {
+	return c.credsFile
+}
+
+func (c config) NkeySeedFile() string {
+	return c.nkeySeed
+}
+
+func (c config) TLS() TLSConfig {
+	return c.tls
+}
+
+func (c tlsConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c tlsConfig) CAFile() string {
+	return c.caFile
+}
+
+func (c tlsConfig) CertFile() string {
+	return c.certFile
+}
+
+func (c tlsConfig) KeyFile() string {
+	return c.keyFile
+}
+
+func (c config) JetStream() JetStreamConfig {
+	return c.jetStream
+}
+
+func (c jetStreamConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c jetStreamConfig) Stream() string {
+	return c.stream
+}
+
+func (c jetStreamConfig) Durable() string {
+	return c.durable
+}
+
+func (c jetStreamConfig) MaxDeliver() int {
+	return c.maxDeliver
+}
+
+func (c jetStreamConfig) BackOff() []time.Duration {
+	return c.backOff
+}
+
+func (c jetStreamConfig) DeadLetterSubject() string {
+	return c.deadLetterSubject
+}
+
+func (c jetStreamConfig) AckWait() time.Duration {
+	return c.ackWait
+}
+
+func (c jetStreamConfig) MaxAge() time.Duration {
+	return c.maxAge
+}
+
+func getenv(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func maxDeliver(value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Printf("invalid max deliver %q, using 5", value)
+		return 5
+	}
+	return n
+}
+
+// backOff parses a comma separated list of durations
+func backOff(value string) []time.Duration {
+	durations := make([]time.Duration, 0)
+	for _, s := range strings.Split(value, ",") {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			log.Printf("invalid backoff duration %q, ignoring it", s)
+			continue
+		}
+		durations = append(durations, d)
+	}
+	return durations
+}
+
+func duration(value string, defaultValue time.Duration) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("invalid duration %q, using %s", value, defaultValue)
+		return defaultValue
+	}
+	return d
 }
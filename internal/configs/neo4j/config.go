@@ -2,7 +2,16 @@ package neo4j
 
 import (
 	"fmt"
+	"log"
 	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	AuthSchemeNone   = "none"
+	AuthSchemeBasic  = "basic"
+	AuthSchemeBearer = "bearer"
 )
 
 type Config interface {
@@ -10,6 +19,22 @@ type Config interface {
 	Username() string
 	Password() string
 	DbName() string
+	// AuthScheme returns none, basic or bearer, it defaults to basic if a username is set
+	AuthScheme() string
+	BearerToken() string
+	// Routing connects to a causal cluster through the neo4j scheme instead of directly to a single instance
+	Routing() bool
+	// the driver defaults are used for pool settings that aren't set
+	MaxConnectionPoolSize() int
+	ConnectionAcquisitionTimeout() time.Duration
+	MaxTransactionRetryTime() time.Duration
+	TLS() TLSConfig
+}
+
+type TLSConfig interface {
+	Enabled() bool
+	// CAFile returns the CA that signed the server certificate, system CAs are trusted if it isn't set
+	CAFile() string
 }
 
 type config struct {
@@ -18,6 +43,19 @@ type config struct {
 	username string
 	password string
 	dbName   string
+	tls      tlsConfig
+
+	authScheme                   string
+	bearerToken                  string
+	routing                      bool
+	maxConnectionPoolSize        int
+	connectionAcquisitionTimeout time.Duration
+	maxTransactionRetryTime      time.Duration
+}
+
+type tlsConfig struct {
+	enabled bool
+	caFile  string
 }
 
 func NewConfig() Config {
@@ -27,11 +65,28 @@ func NewConfig() Config {
 		username: os.Getenv("NEO4J_USERNAME"),
 		password: os.Getenv("NEO4J_PASSWORD"),
 		dbName:   os.Getenv("NEO4J_DBNAME"),
+		tls: tlsConfig{
+			enabled: os.Getenv("NEO4J_TLS_ENABLED") == "true",
+			caFile:  os.Getenv("NEO4J_TLS_CA_FILE"),
+		},
+		authScheme:                   authScheme(os.Getenv("NEO4J_AUTH_SCHEME"), os.Getenv("NEO4J_USERNAME")),
+		bearerToken:                  os.Getenv("NEO4J_BEARER_TOKEN"),
+		routing:                      os.Getenv("NEO4J_ROUTING") == "true",
+		maxConnectionPoolSize:        positiveInt("NEO4J_MAX_CONNECTION_POOL_SIZE"),
+		connectionAcquisitionTimeout: positiveDuration("NEO4J_CONNECTION_ACQUISITION_TIMEOUT"),
+		maxTransactionRetryTime:      positiveDuration("NEO4J_MAX_TRANSACTION_RETRY_TIME"),
 	}
 }
 
 func (c config) Uri() string {
-	return fmt.Sprintf("bolt://%s:%s", c.hostname, c.port)
+	scheme := "bolt"
+	if c.routing {
+		scheme = "neo4j"
+	}
+	if c.tls.enabled {
+		scheme += "+s"
+	}
+	return fmt.Sprintf("%s://%s:%s", scheme, c.hostname, c.port)
 }
 
 func (c config) Username() string {
@@ -45,3 +100,81 @@ func (c config) Password() string {
 func (c config) DbName() string {
 	return c.dbName
 }
+
+func (c config) AuthScheme() string {
+	return c.authScheme
+}
+
+func (c config) BearerToken() string {
+	return c.bearerToken
+}
+
+func (c config) Routing() bool {
+	return c.routing
+}
+
+func (c config) MaxConnectionPoolSize() int {
+	return c.maxConnectionPoolSize
+}
+
+func (c config) ConnectionAcquisitionTimeout() time.Duration {
+	return c.connectionAcquisitionTimeout
+}
+
+func (c config) MaxTransactionRetryTime() time.Duration {
+	return c.maxTransactionRetryTime
+}
+
+func (c config) TLS() TLSConfig {
+	return c.tls
+}
+
+func (c tlsConfig) Enabled() bool {
+	return c.enabled
+}
+
+func (c tlsConfig) CAFile() string {
+	return c.caFile
+}
+
+func authScheme(value, username string) string {
+	switch value {
+	case AuthSchemeNone, AuthSchemeBasic, AuthSchemeBearer:
+		return value
+	case "":
+	default:
+		log.Printf("invalid neo4j auth scheme %q, ignoring it", value)
+	}
+	if username != "" {
+		return AuthSchemeBasic
+	}
+	return AuthSchemeNone
+}
+
+// positiveInt returns 0 if the variable isn't set or isn't a positive integer
+func positiveInt(key string) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		log.Printf("invalid %s %q, ignoring it", key, value)
+		return 0
+	}
+	return n
+}
+
+// positiveDuration returns 0 if the variable isn't set or isn't a positive duration
+func positiveDuration(key string) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("invalid %s %q, ignoring it", key, value)
+		return 0
+	}
+	return d
+}
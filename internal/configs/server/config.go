@@ -4,18 +4,69 @@ import "os"
 
 type Config interface {
 	Port() string
+	TLS() TLSConfig
+}
+
+// TLSConfig enables TLS when a certificate and key are set, and verifies client
+// certificates against the client CA when one is set
+type TLSConfig interface {
+	Enabled() bool
+	CertFile() string
+	KeyFile() string
+	ClientCAFile() string
+	// ClientCertRequired rejects callers without a valid client certificate,
+	// otherwise the certificate is verified only if it is presented
+	ClientCertRequired() bool
 }
 
 type config struct {
 	port string
+	tls  tlsConfig
+}
+
+type tlsConfig struct {
+	certFile           string
+	keyFile            string
+	clientCAFile       string
+	clientCertRequired bool
 }
 
 func NewConfig() Config {
 	return config{
 		port: os.Getenv("OORT_PORT"),
+		tls: tlsConfig{
+			certFile:           os.Getenv("OORT_TLS_CERT_FILE"),
+			keyFile:            os.Getenv("OORT_TLS_KEY_FILE"),
+			clientCAFile:       os.Getenv("OORT_TLS_CLIENT_CA_FILE"),
+			clientCertRequired: os.Getenv("OORT_TLS_CLIENT_CERT_REQUIRED") == "true",
+		},
 	}
 }
 
 func (c config) Port() string {
 	return c.port
 }
+
+func (c config) TLS() TLSConfig {
+	return c.tls
+}
+
+func (c tlsConfig) Enabled() bool {
+	return c.certFile != "" && c.keyFile != ""
+}
+
+func (c tlsConfig) CertFile() string {
+	return c.certFile
+}
+
+func (c tlsConfig) KeyFile() string {
+	return c.keyFile
+}
+
+func (c tlsConfig) ClientCAFile() string {
+	return c.clientCAFile
+}
+
+func (c tlsConfig) ClientCertRequired() bool {
+	return c.clientCertRequired
+}
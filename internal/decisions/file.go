@@ -0,0 +1,138 @@
+package decisions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/mappers/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// backupTimeFormat is the suffix of rotated files, it sorts chronologically
+const backupTimeFormat = "20060102T150405.000000000"
+
+// fileDecisionLogger appends decisions to a file as JSON lines. When the file grows over maxSize
+// it is renamed with a timestamp suffix and a new one is started, keeping at most maxBackups old files.
+type fileDecisionLogger struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	lock       sync.Mutex
+}
+
+func NewFileDecisionLogger(path string, maxSize int64, maxBackups int) (domain.DecisionLogger, error) {
+	if path == "" {
+		return nil, errors.New("decision log path is empty")
+	}
+	if maxSize <= 0 {
+		return nil, errors.New("decision log max size must be positive")
+	}
+	l := &fileDecisionLogger{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	err := l.open()
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *fileDecisionLogger) Log(record domain.DecisionRecord) error {
+	decision, err := proto.DecisionFromDomain(&record)
+	if err != nil {
+		return err
+	}
+	line, err := protojson.Marshal(decision)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize {
+		err = l.rotate()
+		if err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	return err
+}
+
+func (l *fileDecisionLogger) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.file.Close()
+}
+
+func (l *fileDecisionLogger) open() error {
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+func (l *fileDecisionLogger) rotate() error {
+	err := l.file.Close()
+	if err != nil {
+		return err
+	}
+	backup := fmt.Sprintf("%s.%s", l.path, time.Now().UTC().Format(backupTimeFormat))
+	err = os.Rename(l.path, backup)
+	if err != nil {
+		return err
+	}
+	l.removeOldBackups()
+	return l.open()
+}
+
+// removeOldBackups keeps the newest maxBackups files, other files that share the log's name are left alone
+func (l *fileDecisionLogger) removeOldBackups() {
+	if l.maxBackups <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(filepath.Dir(l.path))
+	if err != nil {
+		return
+	}
+	prefix := filepath.Base(l.path) + "."
+	backups := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		suffix, found := strings.CutPrefix(entry.Name(), prefix)
+		if !found || !entry.Type().IsRegular() {
+			continue
+		}
+		if _, err := time.Parse(backupTimeFormat, suffix); err != nil {
+			continue
+		}
+		backups = append(backups, entry.Name())
+	}
+	if len(backups) <= l.maxBackups {
+		return
+	}
+	sort.Strings(backups)
+	for _, backup := range backups[:len(backups)-l.maxBackups] {
+		_ = os.Remove(filepath.Join(filepath.Dir(l.path), backup))
+	}
+}
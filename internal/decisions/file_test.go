@@ -0,0 +1,117 @@
+package decisions
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+func testDecisionRecord(t *testing.T, authorized bool) domain.DecisionRecord {
+	subject, err := domain.NewResource("1", "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := domain.NewResource("1", "doc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return domain.DecisionRecord{
+		Timestamp:      time.Now(),
+		Subject:        *subject,
+		Object:         *object,
+		PermissionName: "read",
+		Authorized:     authorized,
+	}
+}
+
+func countLines(t *testing.T, path string) int {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines++
+	}
+	return lines
+}
+
+func rotatedFiles(t *testing.T, path string) []string {
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated := make([]string, 0)
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), filepath.Base(path)+".") {
+			rotated = append(rotated, entry.Name())
+		}
+	}
+	return rotated
+}
+
+func TestFileDecisionLoggerRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decisions.log")
+	// fits a single record, so every record after the first one starts a new file
+	logger, err := NewFileDecisionLogger(path, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := logger.Log(testDecisionRecord(t, true)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := logger.(*fileDecisionLogger).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := countLines(t, path); lines != 1 {
+		t.Errorf("expected 1 record in the current file, got %d", lines)
+	}
+	rotated := rotatedFiles(t, path)
+	if len(rotated) != 2 {
+		t.Fatalf("expected 2 rotated files, got %v", rotated)
+	}
+	for _, name := range rotated {
+		if lines := countLines(t, filepath.Join(filepath.Dir(path), name)); lines != 1 {
+			t.Errorf("expected 1 record in %s, got %d", name, lines)
+		}
+	}
+}
+
+func TestFileDecisionLoggerRemovesOldBackups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "decisions.log")
+	files := []string{
+		"decisions.log.20240101T000000.000000000",
+		"decisions.log.20240102T000000.000000000",
+		"decisions.log.20240103T000000.000000000",
+		// not created by rotation
+		"decisions.log.bak",
+		"decisions.log.gz",
+		"other.log.20240101T000000.000000000",
+	}
+	for _, name := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0640); err != nil {
+			t.Fatal(err)
+		}
+	}
+	logger := &fileDecisionLogger{path: path, maxBackups: 2}
+	logger.removeOldBackups()
+
+	for _, name := range files {
+		_, err := os.Stat(filepath.Join(dir, name))
+		removed := os.IsNotExist(err)
+		if expected := name == files[0]; removed != expected {
+			t.Errorf("%s: expected removed %v, got %v", name, expected, removed)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package decisions
+
+import (
+	"errors"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+type multiDecisionLogger []domain.DecisionLogger
+
+// NewMultiDecisionLogger logs every decision to all loggers
+func NewMultiDecisionLogger(loggers ...domain.DecisionLogger) domain.DecisionLogger {
+	return multiDecisionLogger(loggers)
+}
+
+func (l multiDecisionLogger) Log(record domain.DecisionRecord) error {
+	errs := make([]error, 0)
+	for _, logger := range l {
+		err := logger.Log(record)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,31 @@
+package decisions
+
+import (
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/mappers/proto"
+	"github.com/c12s/oort/pkg/api"
+	"github.com/c12s/oort/pkg/messaging"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type natsDecisionLogger struct {
+	publisher messaging.Publisher
+}
+
+func NewNatsDecisionLogger(publisher messaging.Publisher) (domain.DecisionLogger, error) {
+	return &natsDecisionLogger{
+		publisher: publisher,
+	}, nil
+}
+
+func (l natsDecisionLogger) Log(record domain.DecisionRecord) error {
+	decision, err := proto.DecisionFromDomain(&record)
+	if err != nil {
+		return err
+	}
+	decisionMarshalled, err := protobuf.Marshal(decision)
+	if err != nil {
+		return err
+	}
+	return l.publisher.Publish(decisionMarshalled, api.DecisionsSubject)
+}
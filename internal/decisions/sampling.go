@@ -0,0 +1,32 @@
+package decisions
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+// samplingDecisionLogger passes on only a share of allowed decisions,
+// denied and failed checks are always logged since they matter the most for audits
+type samplingDecisionLogger struct {
+	next domain.DecisionLogger
+	rate float64
+}
+
+func NewSamplingDecisionLogger(next domain.DecisionLogger, rate float64) (domain.DecisionLogger, error) {
+	if rate < 0 || rate > 1 {
+		return nil, errors.New("sample rate must be between 0 and 1")
+	}
+	return &samplingDecisionLogger{
+		next: next,
+		rate: rate,
+	}, nil
+}
+
+func (l samplingDecisionLogger) Log(record domain.DecisionRecord) error {
+	if record.Authorized && rand.Float64() >= l.rate {
+		return nil
+	}
+	return l.next.Log(record)
+}
@@ -0,0 +1,75 @@
+package decisions
+
+import (
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+type countingDecisionLogger struct {
+	authorized int
+	denied     int
+}
+
+func (l *countingDecisionLogger) Log(record domain.DecisionRecord) error {
+	if record.Authorized {
+		l.authorized++
+	} else {
+		l.denied++
+	}
+	return nil
+}
+
+type samplingTestCase struct {
+	rate               float64
+	expectedAuthorized int
+	expectedDenied     int
+	description        string
+}
+
+var samplingTestCases = []samplingTestCase{
+	{
+		rate:               0,
+		expectedAuthorized: 0,
+		expectedDenied:     10,
+		description:        "nothing sampled, denied always kept",
+	},
+	{
+		rate:               1,
+		expectedAuthorized: 10,
+		expectedDenied:     10,
+		description:        "everything sampled",
+	},
+}
+
+func TestSamplingDecisionLogger(t *testing.T) {
+	for _, testCase := range samplingTestCases {
+		next := &countingDecisionLogger{}
+		logger, err := NewSamplingDecisionLogger(next, testCase.rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 10; i++ {
+			if err := logger.Log(testDecisionRecord(t, true)); err != nil {
+				t.Fatal(err)
+			}
+			if err := logger.Log(testDecisionRecord(t, false)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if next.authorized != testCase.expectedAuthorized {
+			t.Errorf("%s: expected %d authorized, got %d", testCase.description, testCase.expectedAuthorized, next.authorized)
+		}
+		if next.denied != testCase.expectedDenied {
+			t.Errorf("%s: expected %d denied, got %d", testCase.description, testCase.expectedDenied, next.denied)
+		}
+	}
+}
+
+func TestSamplingDecisionLoggerInvalidRate(t *testing.T) {
+	for _, rate := range []float64{-0.1, 1.1} {
+		if _, err := NewSamplingDecisionLogger(&countingDecisionLogger{}, rate); err == nil {
+			t.Errorf("expected an error for rate %v", rate)
+		}
+	}
+}
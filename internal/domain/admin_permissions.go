@@ -0,0 +1,116 @@
+package domain
+
+// permissions that allow callers to administer the graph, granted through ordinary policies
+// so that administration of a subtree can be delegated
+const (
+	PermissionResourceCreate       = "oort.resource.create"
+	PermissionResourceDelete       = "oort.resource.delete"
+	PermissionAttributePut         = "oort.attribute.put"
+	PermissionAttributeDelete      = "oort.attribute.delete"
+	PermissionInheritanceRelCreate = "oort.inheritance.create"
+	PermissionInheritanceRelDelete = "oort.inheritance.delete"
+	PermissionPolicyCreate         = "oort.policy.create"
+	PermissionPolicyDelete         = "oort.policy.delete"
+	PermissionResourceRead         = "oort.resource.read"
+	PermissionPolicyRead           = "oort.policy.read"
+	PermissionAuditRead            = "oort.audit.read"
+	PermissionEventsRead           = "oort.events.read"
+)
+
+// AdministrationReq is implemented by operations and queries that administration callers must be allowed
+type AdministrationReq interface {
+	// RequiredPermission returns the permission the caller must be allowed on all scopes
+	RequiredPermission() (string, []Resource)
+}
+
+// ResourceMerger is implemented by operations that create the resources they refer to if they don't exist yet,
+// creating them requires the resource create permission on the root
+type ResourceMerger interface {
+	MergedResources() []Resource
+}
+
+// new resources are attached to the root, so creating one is checked against the root
+func (req CreateResourceReq) RequiredPermission() (string, []Resource) {
+	return PermissionResourceCreate, []Resource{RootResource}
+}
+
+func (req DeleteResourceReq) RequiredPermission() (string, []Resource) {
+	return PermissionResourceDelete, []Resource{req.Resource}
+}
+
+func (req PutAttributeReq) RequiredPermission() (string, []Resource) {
+	return PermissionAttributePut, []Resource{req.Resource}
+}
+
+func (req DeleteAttributeReq) RequiredPermission() (string, []Resource) {
+	return PermissionAttributeDelete, []Resource{req.Resource}
+}
+
+// a relationship passes the policies of one resource on to the other, so both must be administered by the caller
+func (req CreateInheritanceRelReq) RequiredPermission() (string, []Resource) {
+	return PermissionInheritanceRelCreate, []Resource{req.From, req.To}
+}
+
+func (req DeleteInheritanceRelReq) RequiredPermission() (string, []Resource) {
+	return PermissionInheritanceRelDelete, []Resource{req.From, req.To}
+}
+
+func (req CreatePolicyReq) RequiredPermission() (string, []Resource) {
+	return PermissionPolicyCreate, []Resource{req.ObjectScope}
+}
+
+func (req DeletePolicyReq) RequiredPermission() (string, []Resource) {
+	return PermissionPolicyDelete, []Resource{req.ObjectScope}
+}
+
+func (req PutAttributeReq) MergedResources() []Resource {
+	return []Resource{req.Resource}
+}
+
+func (req CreateInheritanceRelReq) MergedResources() []Resource {
+	return []Resource{req.From, req.To}
+}
+
+func (req CreatePolicyReq) MergedResources() []Resource {
+	return []Resource{req.SubjectScope, req.ObjectScope}
+}
+
+func (req GetResourceReq) RequiredPermission() (string, []Resource) {
+	return PermissionResourceRead, []Resource{req.Resource}
+}
+
+func (req ListResourcesReq) RequiredPermission() (string, []Resource) {
+	return PermissionResourceRead, []Resource{RootResource}
+}
+
+func (req ListChildrenReq) RequiredPermission() (string, []Resource) {
+	return PermissionResourceRead, []Resource{req.Resource}
+}
+
+func (req ListAncestorsReq) RequiredPermission() (string, []Resource) {
+	return PermissionResourceRead, []Resource{req.Resource}
+}
+
+// listed policies are all on the object scope if it is set
+func (req ListPoliciesReq) RequiredPermission() (string, []Resource) {
+	if req.ObjectScope != nil {
+		return PermissionPolicyRead, []Resource{*req.ObjectScope}
+	}
+	return PermissionPolicyRead, []Resource{RootResource}
+}
+
+func (req GetPoliciesForResourceReq) RequiredPermission() (string, []Resource) {
+	return PermissionPolicyRead, []Resource{req.Resource}
+}
+
+func (req ListAuditRecordsReq) RequiredPermission() (string, []Resource) {
+	if req.Resource != nil {
+		return PermissionAuditRead, []Resource{*req.Resource}
+	}
+	return PermissionAuditRead, []Resource{RootResource}
+}
+
+// watch filters don't follow the hierarchy, so watching is checked against the root
+func (req WatchReq) RequiredPermission() (string, []Resource) {
+	return PermissionEventsRead, []Resource{RootResource}
+}
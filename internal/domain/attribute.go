@@ -7,6 +7,10 @@ const (
 	Float64
 	String
 	Bool
+	StringList
+	Int64List
+	Timestamp
+	Duration
 )
 
 type AttributeId struct {
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type actorKey struct{}
+
+type actor struct {
+	name     string
+	verified bool
+}
+
+// WithActor returns a context carrying the authenticated identity of the caller of administration operations
+func WithActor(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor{name: name, verified: true})
+}
+
+// WithUnverifiedActor returns a context carrying the identity the caller claims, which is only recorded
+func WithUnverifiedActor(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor{name: name, verified: false})
+}
+
+// ActorFromContext returns the caller identity, empty if the caller is unknown
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(actor)
+	return actor.name
+}
+
+// ActorVerifiedFromContext reports whether the caller identity was authenticated
+func ActorVerifiedFromContext(ctx context.Context) bool {
+	actor, _ := ctx.Value(actorKey{}).(actor)
+	return actor.name != "" && actor.verified
+}
+
+// AuditRecord is an append-only record of an administration operation
+type AuditRecord struct {
+	Id    string
+	Actor string
+	// false if the actor is only the identity the caller claimed
+	ActorVerified bool
+	Timestamp     time.Time
+	Entity        EventEntity
+	Action        EventAction
+	// state of the affected entity before and after the operation, nil if it didn't exist
+	Before, After *AuditSnapshot
+}
+
+// AuditSnapshot holds only the fields related to the record's entity. Resource snapshots
+// include all attributes of the resource, attribute snapshots only the affected one.
+type AuditSnapshot struct {
+	Resource   Resource
+	Attributes []Attribute
+	From, To   Resource
+	Policy     Permission
+}
+
+// ListAuditRecordsReq filters records, filters that are not set match all records
+type ListAuditRecordsReq struct {
+	Actor string
+	// matches records of operations that refer to the resource in any role
+	Resource *Resource
+	// the time range is half-open, [From, To)
+	From, To  time.Time
+	PageSize  int
+	PageToken string
+}
+
+type ListAuditRecordsResp struct {
+	Records []AuditRecord
+	// empty if there are no more records
+	NextPageToken string
+	Error         error
+}
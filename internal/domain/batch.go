@@ -0,0 +1,45 @@
+package domain
+
+// AdministrationOp is an administration request that can be applied as part of a batch
+type AdministrationOp interface {
+	AdministrationReq
+	ChangeEvent() Event
+	administrationOp()
+}
+
+func (CreateResourceReq) administrationOp()       {}
+func (DeleteResourceReq) administrationOp()       {}
+func (PutAttributeReq) administrationOp()         {}
+func (DeleteAttributeReq) administrationOp()      {}
+func (CreateInheritanceRelReq) administrationOp() {}
+func (DeleteInheritanceRelReq) administrationOp() {}
+func (CreatePolicyReq) administrationOp()         {}
+func (DeletePolicyReq) administrationOp()         {}
+
+type ApplyBatchReq struct {
+	Operations []AdministrationOp
+	// recorded in the audit log, empty if the caller is unknown
+	Actor         string
+	ActorVerified bool
+}
+
+type ApplyBatchResp struct {
+	// results are in the same order as the operations in the request. If an operation fails,
+	// nothing is applied and the results of the other operations are ErrBatchAborted
+	Results []AdministrationResp
+	// set if the batch failed for a reason unrelated to any single operation
+	Error error
+}
+
+// Applied reports whether all operations in the batch have been applied
+func (r ApplyBatchResp) Applied() bool {
+	if r.Error != nil {
+		return false
+	}
+	for _, result := range r.Results {
+		if result.Error != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -1,24 +1,30 @@
 package domain
 
 import (
-	"errors"
 	"github.com/Knetic/govaluate"
 	"go/ast"
 	"go/parser"
 	"go/token"
 	"strings"
+	"time"
 )
 
 type Condition struct {
 	expression string
+	compiled   *govaluate.EvaluableExpression
 }
 
 func NewCondition(expression string) (*Condition, error) {
-	if err := validate(expression); err != nil {
+	if len(expression) == 0 {
+		return &Condition{}, nil
+	}
+	compiled, err := compiledExpressions.get(expression)
+	if err != nil {
 		return nil, err
 	}
 	return &Condition{
 		expression: expression,
+		compiled:   compiled,
 	}, nil
 }
 
@@ -29,35 +35,63 @@ func (c Condition) IsEmpty() bool {
 	return c.expression == ""
 }
 func (c Condition) Eval(sub, obj, env []Attribute) bool {
+	return c.eval(conditionParameters(sub, obj, env))
+}
+
+func (c Condition) eval(parameters map[string]interface{}) bool {
 	if c.IsEmpty() {
 		return true
 	}
 
-	goeExpr, err := govaluate.NewEvaluableExpression(c.expression)
+	compiled := c.compiled
+	if compiled == nil {
+		var err error
+		compiled, err = compiledExpressions.get(c.expression)
+		if err != nil {
+			return false
+		}
+	}
+
+	result, err := compiled.Evaluate(parameters)
 	if err != nil {
 		return false
 	}
+	boolResult, ok := result.(bool)
+	if !ok {
+		return false
+	}
+	return boolResult
+}
 
-	parameters := make(map[string]interface{}, 8)
+func conditionParameters(sub, obj, env []Attribute) map[string]interface{} {
+	parameters := make(map[string]interface{}, len(sub)+len(obj)+len(env))
 	for _, attr := range sub {
-		parameters[SubVarNamePrefix+attr.Name()] = attr.Value()
+		parameters[SubVarNamePrefix+attr.Name()] = conditionValue(attr.Value())
 	}
 	for _, attr := range obj {
-		parameters[ObjVarNamePrefix+attr.Name()] = attr.Value()
+		parameters[ObjVarNamePrefix+attr.Name()] = conditionValue(attr.Value())
 	}
 	for _, attr := range env {
-		parameters[EnvVarNamePrefix+attr.Name()] = attr.Value()
+		parameters[EnvVarNamePrefix+attr.Name()] = conditionValue(attr.Value())
 	}
+	return parameters
+}
 
-	result, err := goeExpr.Evaluate(parameters)
-	if err != nil {
-		return false
-	}
-	boolResult, ok := result.(bool)
-	if !ok {
-		return false
+// conditionValue converts timestamps and durations to seconds, the unit govaluate
+// uses for date literals, so they can be compared and used in arithmetic
+func conditionValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return secondsSinceEpoch(v)
+	case time.Duration:
+		return v.Seconds()
+	default:
+		return value
 	}
-	return boolResult
+}
+
+func secondsSinceEpoch(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/float64(time.Second)
 }
 
 const (
@@ -83,10 +117,10 @@ var validOperations = []token.Token{
 }
 
 var (
-	ErrInvalidOperation    = errors.New("expression operation invalid")
-	ErrInvalidVariableName = errors.New("expression variable name invalid")
-	ErrInvalidNode         = errors.New("expression nodes must be literals, variable names or supported operations")
-	ErrParsing             = errors.New("not an expression")
+	ErrInvalidOperation    = NewError(ErrKindInvalidArgument, "expression operation invalid")
+	ErrInvalidVariableName = NewError(ErrKindInvalidArgument, "expression variable name invalid")
+	ErrInvalidNode         = NewError(ErrKindInvalidArgument, "expression nodes must be literals, variable names, supported operations or function calls")
+	ErrParsing             = NewError(ErrKindInvalidArgument, "not an expression")
 )
 
 func validate(expression string) error {
@@ -97,25 +131,65 @@ func validate(expression string) error {
 	if err != nil {
 		return ErrParsing
 	}
-	ast.Inspect(expr, func(n ast.Node) bool {
-		switch x := n.(type) {
-		case *ast.BasicLit:
-		case *ast.ParenExpr:
-		case *ast.Ident:
-			if !validVariableNamePrefix(x.Name) {
-				err = ErrInvalidVariableName
-			}
-		case *ast.BinaryExpr:
-			if !validOperation(x.Op) {
-				err = ErrInvalidOperation
-			}
-		case nil:
+	_, err = validateExpr(expr)
+	return err
+}
+
+// validateExpr checks that the expression only consists of supported nodes
+// and returns its static type, so that function arguments can be type-checked
+func validateExpr(expr ast.Expr) (exprType, error) {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		switch x.Kind {
+		case token.INT, token.FLOAT:
+			return typeNumber, nil
+		case token.STRING, token.CHAR:
+			return typeString, nil
 		default:
-			err = ErrInvalidNode
+			return typeUnknown, nil
 		}
-		return err == nil
-	})
-	return err
+	case *ast.ParenExpr:
+		return validateExpr(x.X)
+	case *ast.Ident:
+		if !validVariableNamePrefix(x.Name) {
+			return typeUnknown, ErrInvalidVariableName
+		}
+		return typeUnknown, nil
+	case *ast.BinaryExpr:
+		if !validOperation(x.Op) {
+			return typeUnknown, ErrInvalidOperation
+		}
+		left, err := validateExpr(x.X)
+		if err != nil {
+			return typeUnknown, err
+		}
+		right, err := validateExpr(x.Y)
+		if err != nil {
+			return typeUnknown, err
+		}
+		return binaryExprType(x.Op, left, right), nil
+	case *ast.CallExpr:
+		return validateCall(x)
+	default:
+		return typeUnknown, ErrInvalidNode
+	}
+}
+
+func binaryExprType(operation token.Token, left, right exprType) exprType {
+	switch operation {
+	case token.LAND, token.LOR, token.EQL, token.NEQ, token.LSS, token.GTR, token.LEQ, token.GEQ:
+		return typeBool
+	case token.ADD:
+		if left == typeString || right == typeString {
+			return typeString
+		}
+		if left == typeNumber && right == typeNumber {
+			return typeNumber
+		}
+		return typeUnknown
+	default:
+		return typeNumber
+	}
 }
 
 func validVariableNamePrefix(varName string) bool {
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Knetic/govaluate"
+)
+
+var benchmarkDepths = []int{1, 4, 16}
+
+var benchmarkExpressions = []string{
+	"sub_age >= 18 && obj_owner == \"someone-else\"",
+	"env_hour > 9 && env_hour < 17 && sub_clearance > obj_level",
+	"sub_department == obj_department && sub_age * 2 > 100",
+}
+
+var benchmarkEvalRequest = PermissionEvalRequest{
+	Subject: []Attribute{
+		{id: AttributeId{"age"}, kind: Int64, value: int64(30)},
+		{id: AttributeId{"clearance"}, kind: Int64, value: int64(1)},
+		{id: AttributeId{"department"}, kind: String, value: "dev"},
+	},
+	Object: []Attribute{
+		{id: AttributeId{"owner"}, kind: String, value: "someone"},
+		{id: AttributeId{"level"}, kind: Int64, value: int64(3)},
+		{id: AttributeId{"department"}, kind: String, value: "ops"},
+	},
+	Env: []Attribute{
+		{id: AttributeId{"hour"}, kind: Int64, value: int64(20)},
+	},
+}
+
+// benchmarkHierarchy builds a hierarchy with depth x depth levels in which no condition is met.
+// The first subject level falls back to the default result, which ends the evaluation,
+// so depth x len(benchmarkExpressions) conditions are evaluated per check.
+func benchmarkHierarchy(b *testing.B, depth int) PermissionHierarchy {
+	hierarchy := make(PermissionHierarchy, depth)
+	for sub := 0; sub < depth; sub++ {
+		objHierarchy := make(PermissionObjHierarchy, depth)
+		for obj := 0; obj < depth; obj++ {
+			level := make(PermissionLevel, 0, len(benchmarkExpressions))
+			for _, expression := range benchmarkExpressions {
+				condition, err := NewCondition(expression)
+				if err != nil {
+					b.Fatal(err)
+				}
+				permission, err := NewPermission("config.get", PermissionKindAllow, *condition)
+				if err != nil {
+					b.Fatal(err)
+				}
+				level = append(level, *permission)
+			}
+			objHierarchy[PermissionPriority(-obj)] = level
+		}
+		hierarchy[PermissionPriority(-sub)] = objHierarchy
+	}
+	return hierarchy
+}
+
+func BenchmarkPermissionHierarchyEval(b *testing.B) {
+	for _, depth := range benchmarkDepths {
+		hierarchy := benchmarkHierarchy(b, depth)
+		b.Run(fmt.Sprintf("depth=%d", depth), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				hierarchy.Eval(benchmarkEvalRequest)
+			}
+		})
+	}
+}
+
+// BenchmarkPermissionHierarchyEvalReparsed evaluates the same hierarchies the way conditions
+// were evaluated before compilation was introduced, parsing every expression on every check
+func BenchmarkPermissionHierarchyEvalReparsed(b *testing.B) {
+	for _, depth := range benchmarkDepths {
+		hierarchy := benchmarkHierarchy(b, depth)
+		b.Run(fmt.Sprintf("depth=%d", depth), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				evalReparsed(b, hierarchy, benchmarkEvalRequest)
+			}
+		})
+	}
+}
+
+// evalReparsed follows PermissionHierarchy.Eval step by step, including where it stops,
+// so that both benchmarks evaluate the same permissions
+func evalReparsed(b *testing.B, hierarchy PermissionHierarchy, req PermissionEvalRequest) EvalResult {
+	for _, objHierarchy := range hierarchy.sortByPriorityDesc() {
+		res := DefaultEvalResult
+		for _, level := range objHierarchy.sortByPriorityDesc() {
+			levelRes := EvalResultNonEvaluative
+			for _, permission := range level {
+				if !evalConditionReparsed(b, permission.Condition(), req) {
+					continue
+				}
+				if permission.Kind() == PermissionKindDeny {
+					levelRes = EvalResultDenied
+					break
+				}
+				levelRes = EvalResultAllowed
+			}
+			if levelRes != EvalResultNonEvaluative {
+				res = levelRes
+				break
+			}
+		}
+		if res != EvalResultNonEvaluative {
+			return res
+		}
+	}
+	return DefaultEvalResult
+}
+
+func evalConditionReparsed(b *testing.B, condition Condition, req PermissionEvalRequest) bool {
+	expr, err := govaluate.NewEvaluableExpressionWithFunctions(condition.Expression(), expressionFunctions)
+	if err != nil {
+		b.Fatal(err)
+	}
+	res, err := expr.Evaluate(conditionParameters(req.Subject, req.Object, req.Env))
+	if err != nil {
+		return false
+	}
+	met, ok := res.(bool)
+	return ok && met
+}
+
+// BenchmarkConditionEval and BenchmarkConditionEvalReparsed compare a single condition check,
+// independently of how many conditions a hierarchy evaluates
+func BenchmarkConditionEval(b *testing.B) {
+	condition, err := NewCondition(benchmarkExpressions[1])
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		condition.Eval(benchmarkEvalRequest.Subject, benchmarkEvalRequest.Object, benchmarkEvalRequest.Env)
+	}
+}
+
+func BenchmarkConditionEvalReparsed(b *testing.B) {
+	condition, err := NewCondition(benchmarkExpressions[1])
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		evalConditionReparsed(b, *condition, benchmarkEvalRequest)
+	}
+}
+
+// BenchmarkPermissionHierarchyLoadAndEval includes building the hierarchy, as the repo mapper
+// does for every authorization request, so that expression cache hits are accounted for
+func BenchmarkPermissionHierarchyLoadAndEval(b *testing.B) {
+	for _, depth := range benchmarkDepths {
+		b.Run(fmt.Sprintf("depth=%d", depth), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchmarkHierarchy(b, depth).Eval(benchmarkEvalRequest)
+			}
+		})
+	}
+}
+
+func BenchmarkConditionEvalParallel(b *testing.B) {
+	condition, err := NewCondition(benchmarkExpressions[1])
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			condition.Eval(benchmarkEvalRequest.Subject, benchmarkEvalRequest.Object, benchmarkEvalRequest.Env)
+		}
+	})
+}
@@ -0,0 +1,511 @@
+package domain
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Knetic/govaluate"
+)
+
+// exprType is the static type of an expression node. Attribute values are only known
+// at evaluation time, so variables are typeUnknown and match every parameter type.
+type exprType int
+
+const (
+	typeUnknown exprType = iota
+	typeAny
+	typeNumber
+	typeString
+	typeBool
+	typeList
+)
+
+func (t exprType) String() string {
+	switch t {
+	case typeNumber:
+		return "number"
+	case typeString:
+		return "string"
+	case typeBool:
+		return "bool"
+	case typeList:
+		return "list"
+	default:
+		return "any"
+	}
+}
+
+func (t exprType) accepts(arg exprType) bool {
+	return t == typeAny || arg == typeUnknown || t == arg
+}
+
+type conditionFunction struct {
+	params []exprType
+	// the last parameter can be repeated any number of times (including zero)
+	variadic bool
+	returns  exprType
+	// checks constant arguments (such as regex patterns) when the condition is created
+	checkLiterals func(args []ast.Expr) error
+	impl          govaluate.ExpressionFunction
+}
+
+var (
+	ErrUnknownFunction     = NewError(ErrKindInvalidArgument, "expression function unknown")
+	ErrInvalidFunctionArgs = NewError(ErrKindInvalidArgument, "expression function arguments invalid")
+)
+
+var conditionFunctions = map[string]conditionFunction{
+	"in": {
+		params:   []exprType{typeAny, typeAny},
+		variadic: true,
+		returns:  typeBool,
+		impl:     in,
+	},
+	"contains": {
+		params:  []exprType{typeAny, typeAny},
+		returns: typeBool,
+		impl:    contains,
+	},
+	"intersects": {
+		params:  []exprType{typeList, typeList},
+		returns: typeBool,
+		impl:    intersects,
+	},
+	"startsWith": {
+		params:  []exprType{typeString, typeString},
+		returns: typeBool,
+		impl:    startsWith,
+	},
+	"endsWith": {
+		params:  []exprType{typeString, typeString},
+		returns: typeBool,
+		impl:    endsWith,
+	},
+	"matches": {
+		params:        []exprType{typeString, typeString},
+		returns:       typeBool,
+		checkLiterals: checkPatternLiteral,
+		impl:          matches,
+	},
+	"abs": {
+		params:  []exprType{typeNumber},
+		returns: typeNumber,
+		impl:    abs,
+	},
+	"min": {
+		params:   []exprType{typeNumber, typeNumber},
+		variadic: true,
+		returns:  typeNumber,
+		impl:     minimum,
+	},
+	"max": {
+		params:   []exprType{typeNumber, typeNumber},
+		variadic: true,
+		returns:  typeNumber,
+		impl:     maximum,
+	},
+	"timestamp": {
+		params:        []exprType{typeAny},
+		returns:       typeNumber,
+		checkLiterals: checkTimestampLiteral,
+		impl:          timestamp,
+	},
+	"duration": {
+		params:        []exprType{typeString},
+		returns:       typeNumber,
+		checkLiterals: checkDurationLiteral,
+		impl:          duration,
+	},
+	"inCIDR": {
+		params:        []exprType{typeString, typeString},
+		variadic:      true,
+		returns:       typeBool,
+		checkLiterals: checkCIDRLiterals,
+		impl:          inCIDR,
+	},
+}
+
+// expressionFunctions are passed to govaluate when compiling expressions
+var expressionFunctions = func() map[string]govaluate.ExpressionFunction {
+	functions := make(map[string]govaluate.ExpressionFunction, len(conditionFunctions))
+	for name, function := range conditionFunctions {
+		functions[name] = function.impl
+	}
+	return functions
+}()
+
+func validateCall(call *ast.CallExpr) (exprType, error) {
+	name, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return typeUnknown, ErrInvalidNode
+	}
+	function, ok := conditionFunctions[name.Name]
+	if !ok {
+		return typeUnknown, ErrUnknownFunction
+	}
+	if call.Ellipsis.IsValid() {
+		return typeUnknown, ErrInvalidNode
+	}
+	if len(call.Args) < len(function.params) || (!function.variadic && len(call.Args) > len(function.params)) {
+		return typeUnknown, fmt.Errorf("%w: %s expects %s", ErrInvalidFunctionArgs, name.Name, function.signature())
+	}
+	for i, arg := range call.Args {
+		argType, err := validateExpr(arg)
+		if err != nil {
+			return typeUnknown, err
+		}
+		param := function.params[min(i, len(function.params)-1)]
+		if !param.accepts(argType) {
+			return typeUnknown, fmt.Errorf("%w: %s expects %s", ErrInvalidFunctionArgs, name.Name, function.signature())
+		}
+	}
+	if function.checkLiterals != nil {
+		if err := function.checkLiterals(call.Args); err != nil {
+			return typeUnknown, fmt.Errorf("%w: %s: %s", ErrInvalidFunctionArgs, name.Name, err.Error())
+		}
+	}
+	return function.returns, nil
+}
+
+func (f conditionFunction) signature() string {
+	params := make([]string, len(f.params))
+	for i, param := range f.params {
+		params[i] = param.String()
+	}
+	if f.variadic {
+		params[len(params)-1] += "..."
+	}
+	return fmt.Sprintf("(%s)", strings.Join(params, ", "))
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.X
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+func checkPatternLiteral(args []ast.Expr) error {
+	pattern, ok := stringLiteral(args[1])
+	if !ok {
+		return nil
+	}
+	_, err := compilePattern(pattern)
+	return err
+}
+
+func checkTimestampLiteral(args []ast.Expr) error {
+	if value, ok := stringLiteral(args[0]); ok {
+		_, err := time.Parse(time.RFC3339Nano, value)
+		return err
+	}
+	return nil
+}
+
+func checkDurationLiteral(args []ast.Expr) error {
+	if value, ok := stringLiteral(args[0]); ok {
+		_, err := time.ParseDuration(value)
+		return err
+	}
+	return nil
+}
+
+func checkCIDRLiterals(args []ast.Expr) error {
+	if ip, ok := stringLiteral(args[0]); ok && net.ParseIP(ip) == nil {
+		return fmt.Errorf("invalid ip address %q", ip)
+	}
+	for _, arg := range args[1:] {
+		if cidr, ok := stringLiteral(arg); ok {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// in reports whether the first argument equals any of the remaining ones. If only one
+// candidate is given and it is a list, membership in that list is checked instead.
+func in(args ...interface{}) (interface{}, error) {
+	value, candidates := args[0], args[1:]
+	if len(candidates) == 1 {
+		if list, ok := listValues(candidates[0]); ok {
+			candidates = list
+		}
+	}
+	for _, candidate := range candidates {
+		if valuesEqual(value, candidate) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// contains reports whether a list contains the value or whether a string contains the substring
+func contains(args ...interface{}) (interface{}, error) {
+	if list, ok := listValues(args[0]); ok {
+		for _, elem := range list {
+			if valuesEqual(elem, args[1]) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	s, substr, err := stringArgs("contains", args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Contains(s, substr), nil
+}
+
+// intersects reports whether two lists have at least one element in common
+func intersects(args ...interface{}) (interface{}, error) {
+	first, ok := listValues(args[0])
+	if !ok {
+		return nil, fmt.Errorf("intersects: %v is not a list", args[0])
+	}
+	second, ok := listValues(args[1])
+	if !ok {
+		return nil, fmt.Errorf("intersects: %v is not a list", args[1])
+	}
+	for _, x := range first {
+		for _, y := range second {
+			if valuesEqual(x, y) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func startsWith(args ...interface{}) (interface{}, error) {
+	s, prefix, err := stringArgs("startsWith", args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasPrefix(s, prefix), nil
+}
+
+func endsWith(args ...interface{}) (interface{}, error) {
+	s, suffix, err := stringArgs("endsWith", args)
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasSuffix(s, suffix), nil
+}
+
+func matches(args ...interface{}) (interface{}, error) {
+	s, pattern, err := stringArgs("matches", args)
+	if err != nil {
+		return nil, err
+	}
+	regex, err := compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return regex.MatchString(s), nil
+}
+
+func abs(args ...interface{}) (interface{}, error) {
+	x, ok := args[0].(float64)
+	if !ok {
+		return nil, fmt.Errorf("abs: %v is not a number", args[0])
+	}
+	return math.Abs(x), nil
+}
+
+func minimum(args ...interface{}) (interface{}, error) {
+	return reduceNumbers("min", args, math.Min)
+}
+
+func maximum(args ...interface{}) (interface{}, error) {
+	return reduceNumbers("max", args, math.Max)
+}
+
+// timestamp converts an RFC 3339 string to seconds since the epoch. Strings that govaluate
+// already recognized as dates arrive as numbers and are returned unchanged.
+func timestamp(args ...interface{}) (interface{}, error) {
+	switch value := args[0].(type) {
+	case float64:
+		return value, nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, err
+		}
+		return secondsSinceEpoch(t), nil
+	default:
+		return nil, fmt.Errorf("timestamp: %v is not a string", args[0])
+	}
+}
+
+// duration converts a duration string such as "1h30m" to seconds
+func duration(args ...interface{}) (interface{}, error) {
+	value, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("duration: %v is not a string", args[0])
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	return d.Seconds(), nil
+}
+
+// inCIDR reports whether the ip address belongs to any of the given networks
+func inCIDR(args ...interface{}) (interface{}, error) {
+	ipStr, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("inCIDR: %v is not a string", args[0])
+	}
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return false, nil
+	}
+	for _, arg := range args[1:] {
+		cidr, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("inCIDR: %v is not a string", arg)
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if network.Contains(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func stringArgs(name string, args []interface{}) (string, string, error) {
+	first, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf("%s: %v is not a string", name, args[0])
+	}
+	second, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf("%s: %v is not a string", name, args[1])
+	}
+	return first, second, nil
+}
+
+func reduceNumbers(name string, args []interface{}, reduce func(x, y float64) float64) (interface{}, error) {
+	var result float64
+	for i, arg := range args {
+		x, ok := arg.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s: %v is not a number", name, arg)
+		}
+		if i == 0 {
+			result = x
+			continue
+		}
+		result = reduce(result, x)
+	}
+	return result, nil
+}
+
+// listValues returns the elements of a list attribute value, with numbers converted
+// to float64 the same way govaluate converts scalar parameters
+func listValues(value interface{}) ([]interface{}, bool) {
+	switch list := value.(type) {
+	case []interface{}:
+		return list, true
+	case []string:
+		values := make([]interface{}, len(list))
+		for i, elem := range list {
+			values[i] = elem
+		}
+		return values, true
+	case []int64:
+		values := make([]interface{}, len(list))
+		for i, elem := range list {
+			values[i] = float64(elem)
+		}
+		return values, true
+	case []float64:
+		values := make([]interface{}, len(list))
+		for i, elem := range list {
+			values[i] = elem
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+func valuesEqual(x, y interface{}) bool {
+	if xInt, ok := x.(int64); ok {
+		x = float64(xInt)
+	}
+	if yInt, ok := y.(int64); ok {
+		y = float64(yInt)
+	}
+	switch x.(type) {
+	case float64, string, bool:
+		return x == y
+	default:
+		return false
+	}
+}
+
+// maxCompiledPatterns bounds the cache, patterns can come from attribute values
+// that callers set, so the number of distinct ones isn't limited by the conditions
+const maxCompiledPatterns = 10000
+
+var compiledPatterns = &patternCache{patterns: make(map[string]*regexp.Regexp)}
+
+type patternCache struct {
+	mu       sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return compiledPatterns.get(pattern)
+}
+
+func (c *patternCache) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.RLock()
+	regex, ok := c.patterns[pattern]
+	c.mu.RUnlock()
+	if ok {
+		return regex, nil
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	c.put(pattern, regex)
+	return regex, nil
+}
+
+// put starts over when the cache is full, like expressionCache
+func (c *patternCache) put(pattern string, regex *regexp.Regexp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.patterns) >= maxCompiledPatterns {
+		c.patterns = make(map[string]*regexp.Regexp)
+	}
+	c.patterns[pattern] = regex
+}
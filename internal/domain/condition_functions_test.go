@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type conditionFunctionTestCase struct {
+	expression  string
+	sub         []Attribute
+	obj         []Attribute
+	env         []Attribute
+	err         error
+	result      bool
+	description string
+}
+
+var conditionFunctionTestCases = []conditionFunctionTestCase{
+	{
+		expression:  "in(sub_role, \"admin\", \"owner\")",
+		sub:         []Attribute{{id: AttributeId{"role"}, kind: String, value: "owner"}},
+		result:      true,
+		description: "in - value among candidates",
+	},
+	{
+		expression:  "in(sub_level, 1, 2, 3)",
+		sub:         []Attribute{{id: AttributeId{"level"}, kind: Int64, value: int64(4)}},
+		result:      false,
+		description: "in - value not among candidates",
+	},
+	{
+		expression:  "contains(obj_path, \"/secret/\")",
+		obj:         []Attribute{{id: AttributeId{"path"}, kind: String, value: "/app/secret/db"}},
+		result:      true,
+		description: "contains - substring",
+	},
+	{
+		expression:  "startsWith(obj_path, \"/app\") && endsWith(obj_path, \"/db\")",
+		obj:         []Attribute{{id: AttributeId{"path"}, kind: String, value: "/app/secret/db"}},
+		result:      true,
+		description: "startsWith and endsWith",
+	},
+	{
+		expression:  "matches(sub_email, \"^[a-z]+@c12s\\\\.io$\")",
+		sub:         []Attribute{{id: AttributeId{"email"}, kind: String, value: "admin@c12s.io"}},
+		result:      true,
+		description: "matches - regex",
+	},
+	{
+		expression:  "abs(sub_x - obj_x) <= 2 && max(sub_x, obj_x, 10) == 10 && min(sub_x, obj_x) == 3",
+		sub:         []Attribute{{id: AttributeId{"x"}, kind: Int64, value: int64(3)}},
+		obj:         []Attribute{{id: AttributeId{"x"}, kind: Float64, value: 4.5}},
+		result:      true,
+		description: "numeric helpers",
+	},
+	{
+		expression:  "inCIDR(env_ip, \"10.0.0.0/8\", \"192.168.0.0/16\")",
+		env:         []Attribute{{id: AttributeId{"ip"}, kind: String, value: "192.168.1.7"}},
+		result:      true,
+		description: "inCIDR - address in one of the networks",
+	},
+	{
+		expression:  "inCIDR(env_ip, \"10.0.0.0/8\")",
+		env:         []Attribute{{id: AttributeId{"ip"}, kind: String, value: "172.16.0.1"}},
+		result:      false,
+		description: "inCIDR - address outside of the network",
+	},
+	{
+		expression: "contains(sub_groups, \"admins\") && in(obj_level, sub_levels)",
+		sub: []Attribute{
+			{id: AttributeId{"groups"}, kind: StringList, value: []string{"devs", "admins"}},
+			{id: AttributeId{"levels"}, kind: Int64List, value: []int64{1, 2}},
+		},
+		obj:         []Attribute{{id: AttributeId{"level"}, kind: Int64, value: int64(2)}},
+		result:      true,
+		description: "list membership",
+	},
+	{
+		expression:  "intersects(sub_regions, obj_regions)",
+		sub:         []Attribute{{id: AttributeId{"regions"}, kind: StringList, value: []string{"eu-west", "eu-north"}}},
+		obj:         []Attribute{{id: AttributeId{"regions"}, kind: StringList, value: []string{"us-east", "eu-north"}}},
+		result:      true,
+		description: "list intersection",
+	},
+	{
+		expression:  "intersects(sub_regions, obj_regions)",
+		sub:         []Attribute{{id: AttributeId{"regions"}, kind: StringList, value: []string{"eu-west"}}},
+		obj:         []Attribute{{id: AttributeId{"regions"}, kind: StringList, value: []string{}}},
+		result:      false,
+		description: "list intersection with an empty list",
+	},
+	{
+		expression:  "intersects(sub_regions, \"eu-west\")",
+		err:         ErrInvalidFunctionArgs,
+		description: "string literal instead of a list",
+	},
+	{
+		expression:  "env_now < obj_expiresAt && env_now - sub_lastLogin < duration(\"24h\")",
+		sub:         []Attribute{{id: AttributeId{"lastLogin"}, kind: Timestamp, value: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}},
+		obj:         []Attribute{{id: AttributeId{"expiresAt"}, kind: Timestamp, value: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}},
+		env:         []Attribute{{id: AttributeId{"now"}, kind: Timestamp, value: time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)}},
+		result:      true,
+		description: "timestamp comparison and arithmetic",
+	},
+	{
+		expression:  "env_now > timestamp(\"2024-05-01T12:00:00Z\") && sub_session >= duration(\"90m\")",
+		sub:         []Attribute{{id: AttributeId{"session"}, kind: Duration, value: 2 * time.Hour}},
+		env:         []Attribute{{id: AttributeId{"now"}, kind: Timestamp, value: time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)}},
+		result:      false,
+		description: "timestamp and duration literals",
+	},
+	{
+		expression:  "sub_session < duration(\"two hours\")",
+		err:         ErrInvalidFunctionArgs,
+		description: "invalid duration literal",
+	},
+	{
+		expression:  "startsWith(sub_name, 5)",
+		err:         ErrInvalidFunctionArgs,
+		description: "literal argument of the wrong type",
+	},
+	{
+		expression:  "abs(sub_x, sub_y)",
+		err:         ErrInvalidFunctionArgs,
+		description: "too many arguments",
+	},
+	{
+		expression:  "matches(sub_name, \"[a-\")",
+		err:         ErrInvalidFunctionArgs,
+		description: "invalid regex literal",
+	},
+	{
+		expression:  "inCIDR(env_ip, \"10.0.0.0/33\")",
+		err:         ErrInvalidFunctionArgs,
+		description: "invalid cidr literal",
+	},
+	{
+		expression:  "len(sub_name) > 2",
+		err:         ErrUnknownFunction,
+		description: "function that is not whitelisted",
+	},
+	{
+		expression:  "strings.HasPrefix(sub_name, \"a\")",
+		err:         ErrInvalidNode,
+		description: "qualified function name",
+	},
+}
+
+func TestConditionFunctions(t *testing.T) {
+	for _, testCase := range conditionFunctionTestCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			condition, err := NewCondition(c.expression)
+			if !errors.Is(err, c.err) {
+				t.Fatalf("expected error %v, got %v", c.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if result := condition.Eval(c.sub, c.obj, c.env); result != c.result {
+				t.Errorf("expected %v, got %v", c.result, result)
+			}
+		})
+	}
+}
+
+func TestPatternCacheBounded(t *testing.T) {
+	cache := &patternCache{patterns: make(map[string]*regexp.Regexp)}
+	for i := 0; i <= maxCompiledPatterns; i++ {
+		if _, err := cache.get(fmt.Sprintf("^%d$", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(cache.patterns) > maxCompiledPatterns {
+		t.Errorf("expected at most %d cached patterns, got %d", maxCompiledPatterns, len(cache.patterns))
+	}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import "time"
+
+// Decision is the result of evaluating a permission hierarchy along with the policy that produced it
+type Decision struct {
+	Result EvalResult
+	// nil if no policy was decisive and the result is DefaultEvalResult
+	Policy          *Permission
+	SubjectDistance int
+	ObjectDistance  int
+}
+
+// Decide evaluates the hierarchy the same way Eval does, stopping at the deciding policy
+func (hierarchy PermissionHierarchy) Decide(req PermissionEvalRequest) Decision {
+	req = req.withParameters()
+	for _, subPriority := range hierarchy.prioritiesDesc() {
+		objHierarchy := hierarchy[subPriority]
+		for _, objPriority := range objHierarchy.prioritiesDesc() {
+			policy, result := objHierarchy[objPriority].decide(req)
+			if result != EvalResultNonEvaluative {
+				return Decision{
+					Result:          result,
+					Policy:          policy,
+					SubjectDistance: -int(subPriority),
+					ObjectDistance:  -int(objPriority),
+				}
+			}
+		}
+		// an object hierarchy without a decisive level decides by default, as in Eval
+		break
+	}
+	return Decision{Result: DefaultEvalResult}
+}
+
+// decide returns the permission that determines the level's result: the first deny, or else the last allow
+func (level PermissionLevel) decide(req PermissionEvalRequest) (*Permission, EvalResult) {
+	var policy *Permission
+	result := EvalResultNonEvaluative
+	for i := range level {
+		curr := level[i].eval(req)
+		if curr == EvalResultDenied {
+			return &level[i], curr
+		}
+		if curr != EvalResultNonEvaluative {
+			policy = &level[i]
+			result = curr
+		}
+	}
+	return policy, result
+}
+
+// Decision returns the decision described by the explanation
+func (e Explanation) Decision() Decision {
+	if e.FromDefault() {
+		return Decision{Result: e.Result}
+	}
+	level := e.Levels[e.DecidingLevel]
+	decision := Decision{
+		Result:          e.Result,
+		SubjectDistance: level.SubjectDistance,
+		ObjectDistance:  level.ObjectDistance,
+	}
+	for i := range level.Permissions {
+		if level.Permissions[i].Result == EvalResultNonEvaluative {
+			continue
+		}
+		decision.Policy = &level.Permissions[i].Permission
+		if level.Permissions[i].Result == EvalResultDenied {
+			break
+		}
+	}
+	return decision
+}
+
+// DecisionRecord is a compliance record of a single authorization check
+type DecisionRecord struct {
+	Timestamp      time.Time
+	Subject        Resource
+	Object         Resource
+	PermissionName string
+	Env            []Attribute
+	Authorized     bool
+	// set if the check failed before a decision was made
+	Error    error
+	Latency  time.Duration
+	Decision Decision
+}
+
+type DecisionLogger interface {
+	Log(record DecisionRecord) error
+}
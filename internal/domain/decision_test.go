@@ -0,0 +1,31 @@
+package domain
+
+import "testing"
+
+func TestPermissionHierarchyDecide(t *testing.T) {
+	req := PermissionEvalRequest{
+		Subject: []Attribute{{id: AttributeId{"age"}, kind: Int64, value: int64(20)}},
+	}
+	for _, testCase := range explanationTestCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			decision := c.hierarchy.Decide(req)
+			if decision.Result != c.result {
+				t.Errorf("expected result %v, got %v", c.result, decision.Result)
+			}
+			if (decision.Policy == nil) != (c.decidingLevel == NoDecidingLevel) {
+				t.Errorf("expected a deciding policy only if a level is decisive, got %v", decision.Policy)
+			}
+			explained := c.hierarchy.Explain(req).Decision()
+			if explained.Result != decision.Result || explained.SubjectDistance != decision.SubjectDistance ||
+				explained.ObjectDistance != decision.ObjectDistance {
+				t.Errorf("explanation decision %+v differs from %+v", explained, decision)
+			}
+			if decision.Policy != nil && (explained.Policy == nil || explained.Policy.Kind() != decision.Policy.Kind() ||
+				explained.Policy.Condition().Expression() != decision.Policy.Condition().Expression()) {
+				t.Errorf("explanation policy %v differs from %v", explained.Policy, decision.Policy)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import "errors"
+
+type ErrorKind int
+
+const (
+	ErrKindUnknown ErrorKind = iota
+	ErrKindInvalidArgument
+	ErrKindNotFound
+	ErrKindAlreadyExists
+	ErrKindConflict
+	ErrKindFailedPrecondition
+	ErrKindUnavailable
+	ErrKindInternal
+	ErrKindAborted
+	ErrKindUnauthenticated
+	ErrKindPermissionDenied
+)
+
+// Error is an error that carries a kind, so that transports can report it to clients
+// without having to know about every error the domain and repos can return
+type Error struct {
+	kind    ErrorKind
+	message string
+	cause   error
+}
+
+func NewError(kind ErrorKind, message string) error {
+	return &Error{
+		kind:    kind,
+		message: message,
+	}
+}
+
+// WrapError assigns a kind to an error that doesn't have one, keeping its message
+func WrapError(kind ErrorKind, cause error) error {
+	if cause == nil {
+		return nil
+	}
+	return &Error{
+		kind:    kind,
+		message: cause.Error(),
+		cause:   cause,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+func (e *Error) Kind() ErrorKind {
+	return e.kind
+}
+
+// KindOf returns the kind of the first error in err's chain that has one
+func KindOf(err error) ErrorKind {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.kind
+	}
+	return ErrKindUnknown
+}
+
+var (
+	ErrResourceNotFound       = NewError(ErrKindNotFound, "resource not found")
+	ErrAttributeNotFound      = NewError(ErrKindNotFound, "attribute not found")
+	ErrInheritanceRelNotFound = NewError(ErrKindNotFound, "inheritance relationship not found")
+	ErrPolicyNotFound         = NewError(ErrKindNotFound, "policy not found")
+	ErrInvalidResourceName    = NewError(ErrKindInvalidArgument, "invalid resource name format")
+	ErrInheritanceRelExists   = NewError(ErrKindAlreadyExists, "inheritance relationship already exists")
+	ErrInheritanceRelCycle    = NewError(ErrKindFailedPrecondition, "inheritance relationship would create a cycle")
+	ErrBatchAborted           = NewError(ErrKindAborted, "batch aborted, another operation failed")
+	ErrRevisionCompacted      = NewError(ErrKindFailedPrecondition, "events after the revision are no longer retained")
+	ErrUnauthenticated        = NewError(ErrKindUnauthenticated, "caller is not authenticated")
+	ErrPermissionDenied       = NewError(ErrKindPermissionDenied, "caller is not allowed the operation")
+)
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type EventEntity string
+
+const (
+	EventEntityResource       EventEntity = "resource"
+	EventEntityAttribute      EventEntity = "attribute"
+	EventEntityInheritanceRel EventEntity = "inheritance"
+	EventEntityPolicy         EventEntity = "policy"
+)
+
+type EventAction string
+
+const (
+	EventActionCreated EventAction = "created"
+	EventActionDeleted EventAction = "deleted"
+	EventActionPut     EventAction = "put"
+)
+
+// Event describes a change of the graph. Only the fields related to the entity are set.
+type Event struct {
+	// unique, lets consumers discard events delivered more than once
+	Id        string
+	Entity    EventEntity
+	Action    EventAction
+	Revision  int64
+	Timestamp time.Time
+	// the resource itself, or the one whose attribute changed
+	Resource    Resource
+	AttributeId AttributeId
+	// set only when an attribute is put
+	Attribute *Attribute
+	From, To  Resource
+	// permission with its scope set
+	Policy Permission
+}
+
+type EventPublisher interface {
+	Publish(event Event) error
+}
+
+type GetEventsReq struct {
+	AfterRevision   int64
+	UndeliveredOnly bool
+	Limit           int
+}
+
+// GetEventsResp holds events ordered by revision
+type GetEventsResp struct {
+	Events []Event
+	Error  error
+}
+
+type GetRevisionResp struct {
+	Revision int64
+	Error    error
+}
+
+// WatchReq filters streamed events, filters that are not set match all events
+type WatchReq struct {
+	Kind       string
+	NamePrefix string
+	Entities   []EventEntity
+	// nil if only new events are streamed
+	FromRevision *int64
+}
+
+// Matches reports whether the event passes the filters. An event matches the resource filters
+// if any of the resources it refers to does.
+func (req WatchReq) Matches(event Event) bool {
+	if len(req.Entities) > 0 {
+		found := false
+		for _, entity := range req.Entities {
+			if entity == event.Entity {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if req.Kind == "" && req.NamePrefix == "" {
+		return true
+	}
+	for _, resource := range event.Resources() {
+		if (req.Kind == "" || resource.Kind() == req.Kind) && strings.HasPrefix(resource.Name(), req.NamePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resources returns all resources the event refers to
+func (e Event) Resources() []Resource {
+	switch e.Entity {
+	case EventEntityResource, EventEntityAttribute:
+		return []Resource{e.Resource}
+	case EventEntityInheritanceRel:
+		return []Resource{e.From, e.To}
+	case EventEntityPolicy:
+		return []Resource{e.Policy.Scope().Subject, e.Policy.Scope().Object}
+	}
+	return nil
+}
+
+type MarkEventsDeliveredReq struct {
+	Ids []string
+}
+
+type PruneEventsReq struct {
+	DeliveredBefore time.Time
+}
+
+func (req CreateResourceReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityResource, Action: EventActionCreated, Resource: req.Resource}
+}
+
+func (req DeleteResourceReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityResource, Action: EventActionDeleted, Resource: req.Resource}
+}
+
+func (req PutAttributeReq) ChangeEvent() Event {
+	attribute := req.Attribute
+	return Event{
+		Entity:      EventEntityAttribute,
+		Action:      EventActionPut,
+		Resource:    req.Resource,
+		AttributeId: attribute.id,
+		Attribute:   &attribute,
+	}
+}
+
+func (req DeleteAttributeReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityAttribute, Action: EventActionDeleted, Resource: req.Resource, AttributeId: req.AttributeId}
+}
+
+func (req CreateInheritanceRelReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityInheritanceRel, Action: EventActionCreated, From: req.From, To: req.To}
+}
+
+func (req DeleteInheritanceRelReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityInheritanceRel, Action: EventActionDeleted, From: req.From, To: req.To}
+}
+
+func (req CreatePolicyReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityPolicy, Action: EventActionCreated, Policy: policy(req.SubjectScope, req.ObjectScope, req.Permission)}
+}
+
+func (req DeletePolicyReq) ChangeEvent() Event {
+	return Event{Entity: EventEntityPolicy, Action: EventActionDeleted, Policy: policy(req.SubjectScope, req.ObjectScope, req.Permission)}
+}
+
+func policy(subjectScope, objectScope Resource, permission Permission) Permission {
+	permission.SetScope(PermissionScope{
+		Subject: subjectScope,
+		Object:  objectScope,
+	})
+	return permission
+}
@@ -0,0 +1,74 @@
+package domain
+
+import "testing"
+
+type watchMatchTestCase struct {
+	req         WatchReq
+	event       Event
+	matches     bool
+	description string
+}
+
+func eventTestResource(kind, id string) Resource {
+	resource, err := NewResource(id, kind)
+	if err != nil {
+		panic(err)
+	}
+	return *resource
+}
+
+var watchMatchTestCases = []watchMatchTestCase{
+	{
+		req:         WatchReq{},
+		event:       Event{Entity: EventEntityResource, Resource: eventTestResource("user", "1")},
+		matches:     true,
+		description: "no filters",
+	},
+	{
+		req:         WatchReq{Entities: []EventEntity{EventEntityPolicy, EventEntityAttribute}},
+		event:       Event{Entity: EventEntityResource, Resource: eventTestResource("user", "1")},
+		matches:     false,
+		description: "entity not watched",
+	},
+	{
+		req:         WatchReq{Kind: "user"},
+		event:       Event{Entity: EventEntityAttribute, Resource: eventTestResource("user", "1")},
+		matches:     true,
+		description: "kind matches",
+	},
+	{
+		req:         WatchReq{Kind: "user", NamePrefix: "user/2"},
+		event:       Event{Entity: EventEntityResource, Resource: eventTestResource("user", "1")},
+		matches:     false,
+		description: "kind matches, name prefix doesn't",
+	},
+	{
+		req: WatchReq{NamePrefix: "org/"},
+		event: Event{
+			Entity: EventEntityInheritanceRel,
+			From:   eventTestResource("org", "c12s"),
+			To:     eventTestResource("user", "1"),
+		},
+		matches:     true,
+		description: "one of the related resources matches",
+	},
+	{
+		req: WatchReq{Kind: "org", NamePrefix: "user/"},
+		event: Event{
+			Entity: EventEntityInheritanceRel,
+			From:   eventTestResource("org", "c12s"),
+			To:     eventTestResource("user", "1"),
+		},
+		matches:     false,
+		description: "filters match different resources",
+	},
+}
+
+func TestWatchReqMatches(t *testing.T) {
+	for _, testCase := range watchMatchTestCases {
+		matches := testCase.req.Matches(testCase.event)
+		if matches != testCase.matches {
+			t.Errorf("%s: expected %v, got %v", testCase.description, testCase.matches, matches)
+		}
+	}
+}
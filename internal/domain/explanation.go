@@ -0,0 +1,78 @@
+package domain
+
+const NoDecidingLevel = -1
+
+// Explanation describes how a permission hierarchy was evaluated. Levels are listed in
+// evaluation order and every permission is evaluated, including those in levels after
+// the one that produced the result.
+type Explanation struct {
+	Levels        []PermissionLevelExplanation
+	DecidingLevel int
+	Result        EvalResult
+}
+
+// FromDefault reports whether no level was decisive, so the result is DefaultEvalResult
+func (e Explanation) FromDefault() bool {
+	return e.DecidingLevel == NoDecidingLevel
+}
+
+type PermissionLevelExplanation struct {
+	SubjectDistance int
+	ObjectDistance  int
+	Permissions     []PermissionExplanation
+	Result          EvalResult
+}
+
+type PermissionExplanation struct {
+	Permission   Permission
+	ConditionMet bool
+	Result       EvalResult
+}
+
+func (hierarchy PermissionHierarchy) Explain(req PermissionEvalRequest) Explanation {
+	req = req.withParameters()
+	explanation := Explanation{
+		Levels:        make([]PermissionLevelExplanation, 0),
+		DecidingLevel: NoDecidingLevel,
+		Result:        DefaultEvalResult,
+	}
+	decided := false
+	for _, subPriority := range hierarchy.prioritiesDesc() {
+		objHierarchy := hierarchy[subPriority]
+		decidingLevel := NoDecidingLevel
+		for _, objPriority := range objHierarchy.prioritiesDesc() {
+			level := objHierarchy[objPriority].explain(req)
+			level.SubjectDistance = -int(subPriority)
+			level.ObjectDistance = -int(objPriority)
+			explanation.Levels = append(explanation.Levels, level)
+			if decidingLevel == NoDecidingLevel && level.Result != EvalResultNonEvaluative {
+				decidingLevel = len(explanation.Levels) - 1
+			}
+		}
+		// the result is determined the same way Eval determines it
+		if decided {
+			continue
+		}
+		if res := objHierarchy.eval(req); res != EvalResultNonEvaluative {
+			decided = true
+			explanation.Result = res
+			explanation.DecidingLevel = decidingLevel
+		}
+	}
+	return explanation
+}
+
+func (level PermissionLevel) explain(req PermissionEvalRequest) PermissionLevelExplanation {
+	explanation := PermissionLevelExplanation{
+		Permissions: make([]PermissionExplanation, 0, len(level)),
+		Result:      level.eval(req),
+	}
+	for _, permission := range level {
+		explanation.Permissions = append(explanation.Permissions, PermissionExplanation{
+			Permission:   permission,
+			ConditionMet: permission.condition.eval(req.parameters),
+			Result:       permission.eval(req),
+		})
+	}
+	return explanation
+}
@@ -0,0 +1,84 @@
+package domain
+
+import "testing"
+
+type explanationTestCase struct {
+	hierarchy     PermissionHierarchy
+	decidingLevel int
+	result        EvalResult
+	description   string
+}
+
+func explanationTestPermission(kind PermissionKind, expression string) Permission {
+	condition, err := NewCondition(expression)
+	if err != nil {
+		panic(err)
+	}
+	permission, err := NewPermission("config.get", kind, *condition)
+	if err != nil {
+		panic(err)
+	}
+	return *permission
+}
+
+var explanationTestCases = []explanationTestCase{
+	{
+		hierarchy:     PermissionHierarchy{},
+		decidingLevel: NoDecidingLevel,
+		result:        DefaultEvalResult,
+		description:   "empty hierarchy",
+	},
+	{
+		hierarchy: PermissionHierarchy{
+			0: {
+				0:  {explanationTestPermission(PermissionKindAllow, "sub_age > 30")},
+				-1: {explanationTestPermission(PermissionKindAllow, "")},
+			},
+		},
+		decidingLevel: 1,
+		result:        EvalResultAllowed,
+		description:   "closest level is not decisive",
+	},
+	{
+		hierarchy: PermissionHierarchy{
+			0: {
+				-2: {explanationTestPermission(PermissionKindAllow, ""), explanationTestPermission(PermissionKindDeny, "sub_age == 20")},
+			},
+			-1: {
+				0: {explanationTestPermission(PermissionKindAllow, "")},
+			},
+		},
+		decidingLevel: 0,
+		result:        EvalResultDenied,
+		description:   "deny takes precedence within a level",
+	},
+	{
+		hierarchy: PermissionHierarchy{
+			0: {
+				0: {explanationTestPermission(PermissionKindAllow, "sub_age > 30")},
+			},
+		},
+		decidingLevel: NoDecidingLevel,
+		result:        DefaultEvalResult,
+		description:   "no condition met",
+	},
+}
+
+func TestPermissionHierarchyExplain(t *testing.T) {
+	req := PermissionEvalRequest{
+		Subject: []Attribute{{id: AttributeId{"age"}, kind: Int64, value: int64(20)}},
+	}
+	for _, testCase := range explanationTestCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			explanation := c.hierarchy.Explain(req)
+			if explanation.Result != c.result || explanation.Result != c.hierarchy.Eval(req) {
+				t.Errorf("expected result %v, got %v", c.result, explanation.Result)
+			}
+			if explanation.DecidingLevel != c.decidingLevel {
+				t.Errorf("expected deciding level %d, got %d", c.decidingLevel, explanation.DecidingLevel)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"sync"
+
+	"github.com/Knetic/govaluate"
+)
+
+// maxCompiledExpressions bounds the cache, conditions are written by administrators so the
+// number of distinct expressions in use is small, but nothing stops callers from sending
+// arbitrary ones to be validated
+const maxCompiledExpressions = 10000
+
+// compiledExpressions holds condition expressions that have been validated and parsed,
+// keyed by the expression text. Permission hierarchies are loaded from the repo on every
+// evaluation, so without it each check would parse all conditions again.
+var compiledExpressions = &expressionCache{expressions: make(map[string]*govaluate.EvaluableExpression)}
+
+type expressionCache struct {
+	mu          sync.RWMutex
+	expressions map[string]*govaluate.EvaluableExpression
+}
+
+func (c *expressionCache) get(expression string) (*govaluate.EvaluableExpression, error) {
+	c.mu.RLock()
+	compiled, ok := c.expressions[expression]
+	c.mu.RUnlock()
+	if ok {
+		return compiled, nil
+	}
+	if err := validate(expression); err != nil {
+		return nil, err
+	}
+	compiled, err := govaluate.NewEvaluableExpressionWithFunctions(expression, expressionFunctions)
+	if err != nil {
+		return nil, ErrParsing
+	}
+	c.put(expression, compiled)
+	return compiled, nil
+}
+
+// put starts over when the cache is full, expressions still in use are compiled again on their next check
+func (c *expressionCache) put(expression string, compiled *govaluate.EvaluableExpression) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.expressions) >= maxCompiledExpressions {
+		c.expressions = make(map[string]*govaluate.EvaluableExpression)
+	}
+	c.expressions[expression] = compiled
+}
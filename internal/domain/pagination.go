@@ -0,0 +1,18 @@
+package domain
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// PageSize returns the requested page size, falling back to the default
+// if none was requested and capping it at the maximum
+func PageSize(requested int) int {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return requested
+}
@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"log"
 	"sort"
 )
 
@@ -26,12 +25,29 @@ type PermissionEvalRequest struct {
 	Subject []Attribute
 	Object  []Attribute
 	Env     []Attribute
+	// condition parameters are built once per request and shared by all permissions
+	parameters map[string]interface{}
+}
+
+func (req PermissionEvalRequest) withParameters() PermissionEvalRequest {
+	if req.parameters == nil {
+		req.parameters = conditionParameters(req.Subject, req.Object, req.Env)
+	}
+	return req
 }
 
 type Permission struct {
 	name      string
 	kind      PermissionKind
 	condition Condition
+	scope     PermissionScope
+}
+
+// PermissionScope holds the subject and object the permission was directly granted on.
+// It is only known for permissions that were loaded as part of a hierarchy.
+type PermissionScope struct {
+	Subject,
+	Object Resource
 }
 
 func NewPermission(name string, kind PermissionKind, condition Condition) (*Permission, error) {
@@ -54,9 +70,17 @@ func (p Permission) Condition() Condition {
 	return p.condition
 }
 
+func (p Permission) Scope() PermissionScope {
+	return p.scope
+}
+
+func (p *Permission) SetScope(scope PermissionScope) {
+	p.scope = scope
+}
+
 func (p Permission) eval(req PermissionEvalRequest) EvalResult {
-	log.Println("perm eval")
-	if !p.condition.Eval(req.Subject, req.Object, req.Env) {
+	req = req.withParameters()
+	if !p.condition.eval(req.parameters) {
 		return EvalResultNonEvaluative
 	}
 	if p.kind == PermissionKindAllow {
@@ -97,6 +121,14 @@ func (hierarchy PermissionObjHierarchy) eval(req PermissionEvalRequest) EvalResu
 }
 
 func (hierarchy PermissionObjHierarchy) sortByPriorityDesc() []PermissionLevel {
+	levels := make([]PermissionLevel, 0, len(hierarchy))
+	for _, key := range hierarchy.prioritiesDesc() {
+		levels = append(levels, hierarchy[key])
+	}
+	return levels
+}
+
+func (hierarchy PermissionObjHierarchy) prioritiesDesc() []PermissionPriority {
 	keys := make([]PermissionPriority, 0, len(hierarchy))
 	for k := range hierarchy {
 		keys = append(keys, k)
@@ -104,16 +136,13 @@ func (hierarchy PermissionObjHierarchy) sortByPriorityDesc() []PermissionLevel {
 	sort.Slice(keys, func(i, j int) bool {
 		return keys[i] > keys[j]
 	})
-	levels := make([]PermissionLevel, 0, len(hierarchy))
-	for _, key := range keys {
-		levels = append(levels, hierarchy[key])
-	}
-	return levels
+	return keys
 }
 
 type PermissionHierarchy map[PermissionPriority]PermissionObjHierarchy
 
 func (hierarchy PermissionHierarchy) Eval(req PermissionEvalRequest) EvalResult {
+	req = req.withParameters()
 	for _, objHierarchy := range hierarchy.sortByPriorityDesc() {
 		if res := objHierarchy.eval(req); res != EvalResultNonEvaluative {
 			return res
@@ -123,6 +152,14 @@ func (hierarchy PermissionHierarchy) Eval(req PermissionEvalRequest) EvalResult
 }
 
 func (hierarchy PermissionHierarchy) sortByPriorityDesc() []PermissionObjHierarchy {
+	levels := make([]PermissionObjHierarchy, 0, len(hierarchy))
+	for _, key := range hierarchy.prioritiesDesc() {
+		levels = append(levels, hierarchy[key])
+	}
+	return levels
+}
+
+func (hierarchy PermissionHierarchy) prioritiesDesc() []PermissionPriority {
 	keys := make([]PermissionPriority, 0, len(hierarchy))
 	for k := range hierarchy {
 		keys = append(keys, k)
@@ -130,9 +167,5 @@ func (hierarchy PermissionHierarchy) sortByPriorityDesc() []PermissionObjHierarc
 	sort.Slice(keys, func(i, j int) bool {
 		return keys[i] > keys[j]
 	})
-	levels := make([]PermissionObjHierarchy, 0, len(hierarchy))
-	for _, key := range keys {
-		levels = append(levels, hierarchy[key])
-	}
-	return levels
+	return keys
 }
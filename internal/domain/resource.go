@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 )
@@ -32,7 +31,7 @@ func NewResource(id, kind string) (*Resource, error) {
 func NewResourceFromName(name string) (*Resource, error) {
 	split := strings.Split(name, "/")
 	if len(split) < 2 {
-		return nil, errors.New("invalid resource name format")
+		return nil, ErrInvalidResourceName
 	}
 	kind := split[0]
 	id := strings.Join(split[1:], "/")
@@ -48,7 +47,7 @@ func (r Resource) Id() string {
 	return r.id.id
 }
 
-func (r Resource) SetId(id string) {
+func (r *Resource) SetId(id string) {
 	r.id.id = id
 }
 
@@ -56,7 +55,7 @@ func (r Resource) Kind() string {
 	return r.id.kind
 }
 
-func (r Resource) SetKind(kind string) {
+func (r *Resource) SetKind(kind string) {
 	r.id.kind = kind
 }
 
@@ -1,17 +1,26 @@
 package domain
 
 type RHABACRepo interface {
-	CreateResource(req CreateResourceReq) AdministrationResp
-	DeleteResource(req DeleteResourceReq) AdministrationResp
 	GetResource(req GetResourceReq) GetResourceResp
-	PutAttribute(req PutAttributeReq) AdministrationResp
-	DeleteAttribute(req DeleteAttributeReq) AdministrationResp
-	CreateInheritanceRel(req CreateInheritanceRelReq) AdministrationResp
-	DeleteInheritanceRel(req DeleteInheritanceRelReq) AdministrationResp
-	CreatePolicy(req CreatePolicyReq) AdministrationResp
-	DeletePolicy(req DeletePolicyReq) AdministrationResp
+	GetResourcesWithAttributes(req GetResourcesWithAttributesReq) GetResourcesWithAttributesResp
+	GetResources(req GetResourcesReq) GetResourcesResp
+	GetChildren(req ListChildrenReq) ListRelatedResourcesResp
+	GetAncestors(req ListAncestorsReq) ListRelatedResourcesResp
+	// ApplyBatch is the only way to change the graph, every operation is recorded
+	// in the audit log and the outbox in the same transaction
+	ApplyBatch(req ApplyBatchReq) ApplyBatchResp
+	GetAuditRecords(req ListAuditRecordsReq) ListAuditRecordsResp
 	GetPermissionHierarchy(req GetPermissionHierarchyReq) GetPermissionHierarchyResp
+	GetPermissionHierarchies(req GetPermissionHierarchiesReq) GetPermissionHierarchiesResp
 	GetApplicablePolicies(req GetApplicablePoliciesReq) GetApplicablePoliciesResp
+	GetPolicies(req ListPoliciesReq) ListPoliciesResp
+	GetInheritedPolicies(req GetPoliciesForResourceReq) GetPoliciesForResourceResp
+	GetSubjectCandidates(req GetSubjectCandidatesReq) GetSubjectCandidatesResp
+	GetObjectCandidates(req GetObjectCandidatesReq) GetObjectCandidatesResp
+	GetEvents(req GetEventsReq) GetEventsResp
+	GetRevision() GetRevisionResp
+	MarkEventsDelivered(req MarkEventsDeliveredReq) AdministrationResp
+	PruneEvents(req PruneEventsReq) AdministrationResp
 }
 
 type CreateResourceReq struct {
@@ -87,16 +96,53 @@ type GetPermissionHierarchyResp struct {
 	Error     error
 }
 
+// GetPermissionHierarchiesReq asks for the hierarchies of several checks in a single query
+type GetPermissionHierarchiesReq struct {
+	Checks []GetPermissionHierarchyReq
+}
+
+type GetPermissionHierarchiesResp struct {
+	// in the order of the checks
+	Hierarchies []PermissionHierarchy
+	Error       error
+}
+
+// GetResourcesWithAttributesReq asks for several resources along with their attributes
+type GetResourcesWithAttributesReq struct {
+	Resources []Resource
+}
+
+type GetResourcesWithAttributesResp struct {
+	// resources that don't exist are left out
+	Resources []Resource
+	Error     error
+}
+
 type AuthorizationReq struct {
 	Subject,
 	Object Resource
 	PermissionName string
 	Env            []Attribute
+	Explain        bool
 }
 
 type AuthorizationResp struct {
 	Authorized bool
-	Error      error
+	// set only if the request asked for an explanation
+	Explanation *Explanation
+	Error       error
+}
+
+// MaxAuthorizationBatchChecks bounds the checks of a batch, each one is a separate query
+const MaxAuthorizationBatchChecks = 100
+
+type AuthorizationBatchReq struct {
+	Checks []AuthorizationReq
+}
+
+type AuthorizationBatchResp struct {
+	Results []AuthorizationResp
+	Error   error
 }
 
 type GetApplicablePoliciesReq struct {
@@ -128,3 +174,159 @@ type GrantedPermission struct {
 	PermissionName string
 	Object         Resource
 }
+
+type GetAuthorizedSubjectsReq struct {
+	Object         Resource
+	PermissionName string
+	Env            []Attribute
+	// if set, only subjects of this kind are returned
+	SubjectKind string
+	PageSize    int
+	PageToken   string
+}
+
+type GetAuthorizedSubjectsResp struct {
+	Subjects      []Resource
+	NextPageToken string
+	Error         error
+}
+
+// GetSubjectCandidatesReq asks for subjects that inherit a policy granting or denying
+// the permission on the object or one of its ancestors, ordered by name
+type GetSubjectCandidatesReq struct {
+	Object         Resource
+	PermissionName string
+	SubjectKind    string
+	// only subjects with names greater than this one are returned
+	After string
+	Limit int
+}
+
+type GetSubjectCandidatesResp struct {
+	Subjects []Resource
+	Error    error
+}
+
+type ListAccessibleObjectsReq struct {
+	Subject        Resource
+	PermissionName string
+	Env            []Attribute
+	// if set, only objects of this kind are returned
+	ObjectKind string
+	PageSize   int
+	PageToken  string
+}
+
+type ListAccessibleObjectsResp struct {
+	Objects       []Resource
+	NextPageToken string
+	Error         error
+}
+
+// GetObjectCandidatesReq asks for objects that inherit from an object on which the subject
+// or one of its ancestors is granted or denied the permission, ordered by name
+type GetObjectCandidatesReq struct {
+	Subject        Resource
+	PermissionName string
+	ObjectKind     string
+	// only objects with names greater than this one are returned
+	After string
+	Limit int
+}
+
+type GetObjectCandidatesResp struct {
+	Objects []Resource
+	Error   error
+}
+
+type ListResourcesReq struct {
+	// if set, only resources of this kind are returned
+	Kind      string
+	PageSize  int
+	PageToken string
+}
+
+type ListResourcesResp struct {
+	Resources     []Resource
+	NextPageToken string
+	Error         error
+}
+
+// GetResourcesReq asks for resources ordered by name
+type GetResourcesReq struct {
+	Kind string
+	// only resources with names greater than this one are returned
+	After string
+	Limit int
+}
+
+type GetResourcesResp struct {
+	Resources []Resource
+	Error     error
+}
+
+// ListChildrenReq asks for resources that inherit from the resource,
+// directly or through at most MaxDepth relationships (no limit if 0)
+type ListChildrenReq struct {
+	Resource  Resource
+	MaxDepth  int
+	PageSize  int
+	PageToken string
+}
+
+// ListAncestorsReq asks for resources the resource inherits from,
+// directly or through at most MaxDepth relationships (no limit if 0)
+type ListAncestorsReq struct {
+	Resource  Resource
+	MaxDepth  int
+	PageSize  int
+	PageToken string
+}
+
+type RelatedResource struct {
+	Resource Resource
+	// length of the shortest inheritance path between the resources
+	Depth int
+}
+
+type ListRelatedResourcesResp struct {
+	// ordered by depth and then by name
+	Resources     []RelatedResource
+	NextPageToken string
+	Error         error
+}
+
+// ListPoliciesReq filters stored policies, every filter that is not set matches all policies
+type ListPoliciesReq struct {
+	SubjectScope    *Resource
+	ObjectScope     *Resource
+	PermissionName  string
+	PermissionKinds []PermissionKind
+	PageSize        int
+	PageToken       string
+}
+
+type ListPoliciesResp struct {
+	// permissions with their subject and object scopes set
+	Policies      []Permission
+	NextPageToken string
+	Error         error
+}
+
+type GetPoliciesForResourceReq struct {
+	Resource Resource
+}
+
+type GetPoliciesForResourceResp struct {
+	Policies []InheritedPolicy
+	Error    error
+}
+
+// InheritedPolicy is a policy whose subject or object scope is the resource or one of its ancestors
+type InheritedPolicy struct {
+	Policy Permission
+	// whether the resource inherits the policy as a subject or as an object
+	AsSubject bool
+	// length of the shortest inheritance path from the resource to the scope
+	Distance int
+}
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/mappers/proto"
+	"github.com/c12s/oort/pkg/api"
+	"github.com/c12s/oort/pkg/messaging"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type natsEventPublisher struct {
+	publisher messaging.Publisher
+}
+
+func NewNatsEventPublisher(publisher messaging.Publisher) (domain.EventPublisher, error) {
+	return &natsEventPublisher{
+		publisher: publisher,
+	}, nil
+}
+
+func (p natsEventPublisher) Publish(event domain.Event) error {
+	protoEvent, err := proto.EventFromDomain(&event)
+	if err != nil {
+		return err
+	}
+	eventMarshalled, err := protobuf.Marshal(protoEvent)
+	if err != nil {
+		return err
+	}
+	return p.publisher.Publish(eventMarshalled, api.EventSubject(string(event.Entity), string(event.Action)))
+}
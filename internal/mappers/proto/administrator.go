@@ -1,6 +1,8 @@
 package proto
 
 import (
+	"log"
+
 	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/pkg/api"
 )
@@ -125,12 +127,287 @@ func DeletePolicyReqToDomain(req *api.DeletePolicyReq) (*domain.DeletePolicyReq,
 	}, nil
 }
 
+func AdministrationOpToDomain(op *api.AdministrationOp) (domain.AdministrationOp, error) {
+	switch o := op.GetOp().(type) {
+	case *api.AdministrationOp_CreateResource:
+		req, err := CreateResourceReqToDomain(o.CreateResource)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_DeleteResource:
+		req, err := DeleteResourceReqToDomain(o.DeleteResource)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_PutAttribute:
+		req, err := PutAttributeReqToDomain(o.PutAttribute)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_DeleteAttribute:
+		req, err := DeleteAttributeReqToDomain(o.DeleteAttribute)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_CreateInheritanceRel:
+		req, err := CreateInheritanceRelReqToDomain(o.CreateInheritanceRel)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_DeleteInheritanceRel:
+		req, err := DeleteInheritanceRelReqToDomain(o.DeleteInheritanceRel)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_CreatePolicy:
+		req, err := CreatePolicyReqToDomain(o.CreatePolicy)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	case *api.AdministrationOp_DeletePolicy:
+		req, err := DeletePolicyReqToDomain(o.DeletePolicy)
+		if err != nil {
+			return nil, err
+		}
+		return *req, nil
+	default:
+		return nil, domain.NewError(domain.ErrKindInvalidArgument, "operation missing")
+	}
+}
+
+func ApplyBatchReqToDomain(req *api.ApplyBatchReq) (*domain.ApplyBatchReq, error) {
+	operations := make([]domain.AdministrationOp, len(req.Operations))
+	for i, op := range req.Operations {
+		domainOp, err := AdministrationOpToDomain(op)
+		if err != nil {
+			return nil, err
+		}
+		operations[i] = domainOp
+	}
+	return &domain.ApplyBatchReq{
+		Operations: operations,
+	}, nil
+}
+
+func ApplyBatchRespFromDomain(resp *domain.ApplyBatchResp) (*api.ApplyBatchResp, error) {
+	return &api.ApplyBatchResp{
+		Applied: resp.Applied(),
+		Results: AdministrationOpResultsFromDomain(resp.Results),
+	}, nil
+}
+
+func AdministrationOpResultsFromDomain(results []domain.AdministrationResp) []*api.AdministrationOpResult {
+	opResults := make([]*api.AdministrationOpResult, len(results))
+	for i, result := range results {
+		err := ""
+		if result.Error != nil {
+			err = result.Error.Error()
+		}
+		opResults[i] = &api.AdministrationOpResult{
+			ErrorCode: ErrorCodeFromDomain(result.Error),
+			Error:     err,
+		}
+	}
+	return opResults
+}
+
+func ApplyBatchAsyncRespFromDomain(resp domain.ApplyBatchResp) (*api.AdministrationAsyncResp, error) {
+	err := resp.Error
+	if err == nil && !resp.Applied() {
+		err = domain.ErrBatchAborted
+	}
+	asyncResp, _ := AdministrationAsyncRespFromDomain(domain.AdministrationResp{Error: err})
+	asyncResp.Results = AdministrationOpResultsFromDomain(resp.Results)
+	return asyncResp, nil
+}
+
 func AdministrationAsyncRespFromDomain(resp domain.AdministrationResp) (*api.AdministrationAsyncResp, error) {
 	err := ""
 	if resp.Error != nil {
 		err = resp.Error.Error()
 	}
 	return &api.AdministrationAsyncResp{
-		Error: err,
+		Error:     err,
+		ErrorCode: ErrorCodeFromDomain(resp.Error),
+	}, nil
+}
+
+func GetResourceReqToDomain(req *api.GetResourceReq) (*domain.GetResourceReq, error) {
+	resource, err := ResourceToDomain(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.GetResourceReq{
+		Resource: *resource,
+	}, nil
+}
+
+func GetResourceRespFromDomain(resp *domain.GetResourceResp) (*api.GetResourceResp, error) {
+	resource, err := ResourceFromDomain(resp.Resource)
+	if err != nil {
+		return nil, err
+	}
+	attributes := make([]*api.Attribute, 0, len(resp.Resource.Attributes))
+	for _, domainAttr := range resp.Resource.Attributes {
+		attr, err := AttributeFromDomain(&domainAttr)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		attributes = append(attributes, attr)
+	}
+	return &api.GetResourceResp{
+		Resource:   resource,
+		Attributes: attributes,
+	}, nil
+}
+
+func ListResourcesReqToDomain(req *api.ListResourcesReq) (*domain.ListResourcesReq, error) {
+	return &domain.ListResourcesReq{
+		Kind:      req.Kind,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}, nil
+}
+
+func ListResourcesRespFromDomain(resp *domain.ListResourcesResp) (*api.ListResourcesResp, error) {
+	resources := make([]*api.Resource, 0, len(resp.Resources))
+	for _, domainRes := range resp.Resources {
+		resource, err := ResourceFromDomain(&domainRes)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		resources = append(resources, resource)
+	}
+	return &api.ListResourcesResp{
+		Resources:     resources,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func ListChildrenReqToDomain(req *api.ListChildrenReq) (*domain.ListChildrenReq, error) {
+	resource, err := ResourceToDomain(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListChildrenReq{
+		Resource:  *resource,
+		MaxDepth:  int(req.MaxDepth),
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}, nil
+}
+
+func ListAncestorsReqToDomain(req *api.ListAncestorsReq) (*domain.ListAncestorsReq, error) {
+	resource, err := ResourceToDomain(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListAncestorsReq{
+		Resource:  *resource,
+		MaxDepth:  int(req.MaxDepth),
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}, nil
+}
+
+func ListRelatedResourcesRespFromDomain(resp *domain.ListRelatedResourcesResp) (*api.ListRelatedResourcesResp, error) {
+	resources := make([]*api.RelatedResource, 0, len(resp.Resources))
+	for _, related := range resp.Resources {
+		resource, err := ResourceFromDomain(&related.Resource)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		resources = append(resources, &api.RelatedResource{
+			Resource: resource,
+			Depth:    int32(related.Depth),
+		})
+	}
+	return &api.ListRelatedResourcesResp{
+		Resources:     resources,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func ListPoliciesReqToDomain(req *api.ListPoliciesReq) (*domain.ListPoliciesReq, error) {
+	var subScope, objScope *domain.Resource
+	var err error
+	if req.SubjectScope != nil {
+		subScope, err = ResourceToDomain(req.SubjectScope)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.ObjectScope != nil {
+		objScope, err = ResourceToDomain(req.ObjectScope)
+		if err != nil {
+			return nil, err
+		}
+	}
+	permKinds := make([]domain.PermissionKind, len(req.PermissionKinds))
+	for i, kind := range req.PermissionKinds {
+		permKinds[i] = domain.PermissionKind(kind)
+	}
+	return &domain.ListPoliciesReq{
+		SubjectScope:    subScope,
+		ObjectScope:     objScope,
+		PermissionName:  req.PermissionName,
+		PermissionKinds: permKinds,
+		PageSize:        int(req.PageSize),
+		PageToken:       req.PageToken,
+	}, nil
+}
+
+func ListPoliciesRespFromDomain(resp *domain.ListPoliciesResp) (*api.ListPoliciesResp, error) {
+	policies := make([]*api.Policy, 0, len(resp.Policies))
+	for _, domainPolicy := range resp.Policies {
+		policy, err := PolicyFromDomain(&domainPolicy)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		policies = append(policies, policy)
+	}
+	return &api.ListPoliciesResp{
+		Policies:      policies,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func GetPoliciesForResourceReqToDomain(req *api.GetPoliciesForResourceReq) (*domain.GetPoliciesForResourceReq, error) {
+	resource, err := ResourceToDomain(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.GetPoliciesForResourceReq{
+		Resource: *resource,
+	}, nil
+}
+
+func GetPoliciesForResourceRespFromDomain(resp *domain.GetPoliciesForResourceResp) (*api.GetPoliciesForResourceResp, error) {
+	policies := make([]*api.InheritedPolicy, 0, len(resp.Policies))
+	for _, inherited := range resp.Policies {
+		policy, err := PolicyFromDomain(&inherited.Policy)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		policies = append(policies, &api.InheritedPolicy{
+			Policy:    policy,
+			AsSubject: inherited.AsSubject,
+			Distance:  int32(inherited.Distance),
+		})
+	}
+	return &api.GetPoliciesForResourceResp{
+		Policies: policies,
 	}, nil
 }
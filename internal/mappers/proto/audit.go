@@ -0,0 +1,125 @@
+package proto
+
+import (
+	"log"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var auditEntities = map[domain.EventEntity]api.EventEntity{
+	domain.EventEntityResource:       api.EventEntity_RESOURCE,
+	domain.EventEntityAttribute:      api.EventEntity_ATTRIBUTE,
+	domain.EventEntityInheritanceRel: api.EventEntity_INHERITANCE_REL,
+	domain.EventEntityPolicy:         api.EventEntity_POLICY,
+}
+
+func ListAuditRecordsReqToDomain(req *api.ListAuditRecordsReq) (*domain.ListAuditRecordsReq, error) {
+	var resource *domain.Resource
+	var err error
+	if req.Resource != nil {
+		resource, err = ResourceToDomain(req.Resource)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	return &domain.ListAuditRecordsReq{
+		Actor:     req.Actor,
+		Resource:  resource,
+		From:      from,
+		To:        to,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}, nil
+}
+
+func ListAuditRecordsRespFromDomain(resp *domain.ListAuditRecordsResp) (*api.ListAuditRecordsResp, error) {
+	records := make([]*api.AuditRecord, 0, len(resp.Records))
+	for _, domainRecord := range resp.Records {
+		record, err := AuditRecordFromDomain(&domainRecord)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		records = append(records, record)
+	}
+	return &api.ListAuditRecordsResp{
+		Records:       records,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func AuditRecordFromDomain(record *domain.AuditRecord) (*api.AuditRecord, error) {
+	entity, ok := auditEntities[record.Entity]
+	if !ok {
+		return nil, domain.NewError(domain.ErrKindInternal, "unknown audit record entity")
+	}
+	action, ok := eventActions[record.Action]
+	if !ok {
+		return nil, domain.NewError(domain.ErrKindInternal, "unknown audit record action")
+	}
+	before, err := AuditSnapshotFromDomain(record.Entity, record.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := AuditSnapshotFromDomain(record.Entity, record.After)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AuditRecord{
+		Id:            record.Id,
+		Actor:         record.Actor,
+		ActorVerified: record.ActorVerified,
+		Timestamp:     timestamppb.New(record.Timestamp),
+		Entity:        entity,
+		Action:        action,
+		Before:        before,
+		After:         after,
+	}, nil
+}
+
+func AuditSnapshotFromDomain(entity domain.EventEntity, snapshot *domain.AuditSnapshot) (*api.AuditSnapshot, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	resp := &api.AuditSnapshot{}
+	var err error
+	switch entity {
+	case domain.EventEntityResource, domain.EventEntityAttribute:
+		resp.Resource, err = ResourceFromDomain(&snapshot.Resource)
+		if err != nil {
+			return nil, err
+		}
+		resp.Attributes = make([]*api.Attribute, len(snapshot.Attributes))
+		for i := range snapshot.Attributes {
+			resp.Attributes[i], err = AttributeFromDomain(&snapshot.Attributes[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+	case domain.EventEntityInheritanceRel:
+		resp.From, err = ResourceFromDomain(&snapshot.From)
+		if err != nil {
+			return nil, err
+		}
+		resp.To, err = ResourceFromDomain(&snapshot.To)
+		if err != nil {
+			return nil, err
+		}
+	case domain.EventEntityPolicy:
+		resp.Policy, err = PolicyFromDomain(&snapshot.Policy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
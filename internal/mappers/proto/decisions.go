@@ -0,0 +1,47 @@
+package proto
+
+import (
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func DecisionFromDomain(record *domain.DecisionRecord) (*api.Decision, error) {
+	subject, err := ResourceFromDomain(&record.Subject)
+	if err != nil {
+		return nil, err
+	}
+	object, err := ResourceFromDomain(&record.Object)
+	if err != nil {
+		return nil, err
+	}
+	env := make([]*api.Attribute, len(record.Env))
+	for i := range record.Env {
+		env[i], err = AttributeFromDomain(&record.Env[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := &api.Decision{
+		Timestamp:       timestamppb.New(record.Timestamp),
+		Subject:         subject,
+		Object:          object,
+		PermissionName:  record.PermissionName,
+		Env:             env,
+		Authorized:      record.Authorized,
+		Latency:         durationpb.New(record.Latency),
+		SubjectDistance: int32(record.Decision.SubjectDistance),
+		ObjectDistance:  int32(record.Decision.ObjectDistance),
+	}
+	if record.Error != nil {
+		resp.Error = record.Error.Error()
+	}
+	if record.Decision.Policy != nil {
+		resp.DecidingPolicy, err = PolicyFromDomain(record.Decision.Policy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+package proto
+
+import (
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type errorCodes struct {
+	grpc  codes.Code
+	async api.ErrorCode
+}
+
+// errorKindCodes is the single mapping of domain error kinds to the codes
+// reported by the grpc servers and in async responses
+var errorKindCodes = map[domain.ErrorKind]errorCodes{
+	domain.ErrKindUnknown:            {grpc: codes.Unknown, async: api.ErrorCode_UNKNOWN},
+	domain.ErrKindInvalidArgument:    {grpc: codes.InvalidArgument, async: api.ErrorCode_INVALID_ARGUMENT},
+	domain.ErrKindNotFound:           {grpc: codes.NotFound, async: api.ErrorCode_NOT_FOUND},
+	domain.ErrKindAlreadyExists:      {grpc: codes.AlreadyExists, async: api.ErrorCode_ALREADY_EXISTS},
+	domain.ErrKindConflict:           {grpc: codes.Aborted, async: api.ErrorCode_CONFLICT},
+	domain.ErrKindFailedPrecondition: {grpc: codes.FailedPrecondition, async: api.ErrorCode_FAILED_PRECONDITION},
+	domain.ErrKindUnavailable:        {grpc: codes.Unavailable, async: api.ErrorCode_UNAVAILABLE},
+	domain.ErrKindInternal:           {grpc: codes.Internal, async: api.ErrorCode_INTERNAL},
+	domain.ErrKindAborted:            {grpc: codes.Aborted, async: api.ErrorCode_ABORTED},
+	domain.ErrKindUnauthenticated:    {grpc: codes.Unauthenticated, async: api.ErrorCode_UNAUTHENTICATED},
+	domain.ErrKindPermissionDenied:   {grpc: codes.PermissionDenied, async: api.ErrorCode_PERMISSION_DENIED},
+}
+
+func errorCodesFromDomain(err error) errorCodes {
+	if kindCodes, ok := errorKindCodes[domain.KindOf(err)]; ok {
+		return kindCodes
+	}
+	return errorKindCodes[domain.ErrKindUnknown]
+}
+
+func ErrorCodeFromDomain(err error) api.ErrorCode {
+	if err == nil {
+		return api.ErrorCode_OK
+	}
+	return errorCodesFromDomain(err).async
+}
+
+// GrpcErrorFromDomain converts an error to a grpc status error with the code matching its kind
+func GrpcErrorFromDomain(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(errorCodesFromDomain(err).grpc, err.Error())
+}
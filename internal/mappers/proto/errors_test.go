@@ -0,0 +1,130 @@
+package proto
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type errorCodesTestCase struct {
+	err         error
+	grpc        codes.Code
+	async       api.ErrorCode
+	description string
+}
+
+var errorCodesTestCases = []errorCodesTestCase{
+	{
+		err:         domain.ErrResourceNotFound,
+		grpc:        codes.NotFound,
+		async:       api.ErrorCode_NOT_FOUND,
+		description: "not found",
+	},
+	{
+		err:         domain.ErrInvalidResourceName,
+		grpc:        codes.InvalidArgument,
+		async:       api.ErrorCode_INVALID_ARGUMENT,
+		description: "invalid argument",
+	},
+	{
+		err:         domain.ErrInheritanceRelExists,
+		grpc:        codes.AlreadyExists,
+		async:       api.ErrorCode_ALREADY_EXISTS,
+		description: "already exists",
+	},
+	{
+		err:         domain.NewError(domain.ErrKindConflict, "conflict"),
+		grpc:        codes.Aborted,
+		async:       api.ErrorCode_CONFLICT,
+		description: "conflict",
+	},
+	{
+		err:         domain.ErrInheritanceRelCycle,
+		grpc:        codes.FailedPrecondition,
+		async:       api.ErrorCode_FAILED_PRECONDITION,
+		description: "failed precondition",
+	},
+	{
+		err:         domain.NewError(domain.ErrKindUnavailable, "unavailable"),
+		grpc:        codes.Unavailable,
+		async:       api.ErrorCode_UNAVAILABLE,
+		description: "unavailable",
+	},
+	{
+		err:         domain.NewError(domain.ErrKindInternal, "internal"),
+		grpc:        codes.Internal,
+		async:       api.ErrorCode_INTERNAL,
+		description: "internal",
+	},
+	{
+		err:         domain.ErrBatchAborted,
+		grpc:        codes.Aborted,
+		async:       api.ErrorCode_ABORTED,
+		description: "aborted",
+	},
+	{
+		err:         domain.ErrUnauthenticated,
+		grpc:        codes.Unauthenticated,
+		async:       api.ErrorCode_UNAUTHENTICATED,
+		description: "unauthenticated",
+	},
+	{
+		err:         domain.ErrPermissionDenied,
+		grpc:        codes.PermissionDenied,
+		async:       api.ErrorCode_PERMISSION_DENIED,
+		description: "permission denied",
+	},
+	{
+		err:         fmt.Errorf("getting resource: %w", domain.ErrResourceNotFound),
+		grpc:        codes.NotFound,
+		async:       api.ErrorCode_NOT_FOUND,
+		description: "wrapped domain error keeps its kind",
+	},
+	{
+		err:         errors.New("no kind"),
+		grpc:        codes.Unknown,
+		async:       api.ErrorCode_UNKNOWN,
+		description: "error without a kind",
+	},
+}
+
+func TestErrorCodesFromDomain(t *testing.T) {
+	for _, testCase := range errorCodesTestCases {
+		grpcErr := GrpcErrorFromDomain(testCase.err)
+		if code := status.Code(grpcErr); code != testCase.grpc {
+			t.Errorf("%s: expected grpc code %v, got %v", testCase.description, testCase.grpc, code)
+		}
+		if code := ErrorCodeFromDomain(testCase.err); code != testCase.async {
+			t.Errorf("%s: expected async code %v, got %v", testCase.description, testCase.async, code)
+		}
+	}
+}
+
+func TestErrorCodesFromDomainNil(t *testing.T) {
+	if err := GrpcErrorFromDomain(nil); err != nil {
+		t.Errorf("expected no grpc error, got %v", err)
+	}
+	if code := ErrorCodeFromDomain(nil); code != api.ErrorCode_OK {
+		t.Errorf("expected async code %v, got %v", api.ErrorCode_OK, code)
+	}
+}
+
+func TestGrpcErrorFromDomainKeepsStatus(t *testing.T) {
+	err := status.Error(codes.ResourceExhausted, "limited")
+	if code := status.Code(GrpcErrorFromDomain(err)); code != codes.ResourceExhausted {
+		t.Errorf("expected grpc code %v, got %v", codes.ResourceExhausted, code)
+	}
+}
+
+func TestErrorKindCodesComplete(t *testing.T) {
+	for kind := domain.ErrKindUnknown; kind <= domain.ErrKindPermissionDenied; kind++ {
+		if _, ok := errorKindCodes[kind]; !ok {
+			t.Errorf("no codes for error kind %v", kind)
+		}
+	}
+}
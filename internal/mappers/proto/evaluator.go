@@ -1,6 +1,7 @@
 package proto
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/c12s/oort/internal/domain"
@@ -30,6 +31,122 @@ func AuthorizationReqToDomain(req *api.AuthorizationReq) (*domain.AuthorizationR
 		Object:         *obj,
 		PermissionName: req.PermissionName,
 		Env:            envAttributes,
+		Explain:        req.Explain,
+	}, nil
+}
+
+func AuthorizationBatchReqToDomain(req *api.AuthorizationBatchReq) (*domain.AuthorizationBatchReq, error) {
+	if len(req.Checks) > domain.MaxAuthorizationBatchChecks {
+		return nil, domain.NewError(domain.ErrKindInvalidArgument,
+			fmt.Sprintf("a batch can hold at most %d checks", domain.MaxAuthorizationBatchChecks))
+	}
+	checks := make([]domain.AuthorizationReq, len(req.Checks))
+	for i, check := range req.Checks {
+		domainCheck, err := AuthorizationReqToDomain(check)
+		if err != nil {
+			return nil, err
+		}
+		checks[i] = *domainCheck
+	}
+	return &domain.AuthorizationBatchReq{
+		Checks: checks,
+	}, nil
+}
+
+func AuthorizationBatchRespFromDomain(resp *domain.AuthorizationBatchResp) (*api.AuthorizationBatchResp, error) {
+	results := make([]*api.AuthorizationResult, len(resp.Results))
+	for i, domainResult := range resp.Results {
+		result, err := AuthorizationResultFromDomain(&domainResult)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+	}
+	return &api.AuthorizationBatchResp{
+		Results: results,
+	}, nil
+}
+
+func AuthorizationResultFromDomain(resp *domain.AuthorizationResp) (*api.AuthorizationResult, error) {
+	if resp.Error != nil {
+		return &api.AuthorizationResult{
+			Authorized: false,
+			ErrorCode:  ErrorCodeFromDomain(resp.Error),
+			Error:      resp.Error.Error(),
+		}, nil
+	}
+	authResp, err := AuthorizationRespFromDomain(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AuthorizationResult{
+		Authorized:  authResp.Authorized,
+		Explanation: authResp.Explanation,
+		ErrorCode:   api.ErrorCode_OK,
+	}, nil
+}
+
+func AuthorizationRespFromDomain(resp *domain.AuthorizationResp) (*api.AuthorizationResp, error) {
+	var explanation *api.Explanation
+	if resp.Explanation != nil {
+		var err error
+		explanation, err = ExplanationFromDomain(resp.Explanation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &api.AuthorizationResp{
+		Authorized:  resp.Authorized,
+		Explanation: explanation,
+	}, nil
+}
+
+func ExplanationFromDomain(explanation *domain.Explanation) (*api.Explanation, error) {
+	levels := make([]*api.PermissionLevelExplanation, 0, len(explanation.Levels))
+	for _, domainLevel := range explanation.Levels {
+		permissions := make([]*api.PermissionExplanation, 0, len(domainLevel.Permissions))
+		for _, domainPerm := range domainLevel.Permissions {
+			perm, err := PermissionExplanationFromDomain(&domainPerm)
+			if err != nil {
+				return nil, err
+			}
+			permissions = append(permissions, perm)
+		}
+		levels = append(levels, &api.PermissionLevelExplanation{
+			SubjectDistance: int32(domainLevel.SubjectDistance),
+			ObjectDistance:  int32(domainLevel.ObjectDistance),
+			Permissions:     permissions,
+			Result:          api.EvalResult(domainLevel.Result),
+		})
+	}
+	return &api.Explanation{
+		Levels:        levels,
+		DecidingLevel: int32(explanation.DecidingLevel),
+		DefaultResult: explanation.FromDefault(),
+		Result:        api.EvalResult(explanation.Result),
+	}, nil
+}
+
+func PermissionExplanationFromDomain(explanation *domain.PermissionExplanation) (*api.PermissionExplanation, error) {
+	permission, err := PermissionFromDomain(&explanation.Permission)
+	if err != nil {
+		return nil, err
+	}
+	scope := explanation.Permission.Scope()
+	subScope, err := ResourceFromDomain(&scope.Subject)
+	if err != nil {
+		return nil, err
+	}
+	objScope, err := ResourceFromDomain(&scope.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PermissionExplanation{
+		Permission:   permission,
+		SubjectScope: subScope,
+		ObjectScope:  objScope,
+		ConditionMet: explanation.ConditionMet,
+		Result:       api.EvalResult(explanation.Result),
 	}, nil
 }
 
@@ -67,3 +184,83 @@ func GetGrantedPermissionsRespFromDomain(resp *domain.GetGrantedPermissionsResp)
 		Permissions: perms,
 	}, nil
 }
+
+func GetAuthorizedSubjectsReqToDomain(req *api.GetAuthorizedSubjectsReq) (*domain.GetAuthorizedSubjectsReq, error) {
+	envAttributes := make([]domain.Attribute, len(req.EnvAttributes))
+	for i, attr := range req.EnvAttributes {
+		domainAttr, err := AttributeToDomain(attr)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		envAttributes[i] = *domainAttr
+	}
+	obj, err := ResourceToDomain(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.GetAuthorizedSubjectsReq{
+		Object:         *obj,
+		PermissionName: req.PermissionName,
+		Env:            envAttributes,
+		SubjectKind:    req.SubjectKind,
+		PageSize:       int(req.PageSize),
+		PageToken:      req.PageToken,
+	}, nil
+}
+
+func GetAuthorizedSubjectsRespFromDomain(resp *domain.GetAuthorizedSubjectsResp) (*api.GetAuthorizedSubjectsResp, error) {
+	subjects := make([]*api.Resource, 0, len(resp.Subjects))
+	for _, domainSub := range resp.Subjects {
+		sub, err := ResourceFromDomain(&domainSub)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		subjects = append(subjects, sub)
+	}
+	return &api.GetAuthorizedSubjectsResp{
+		Subjects:      subjects,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func ListAccessibleObjectsReqToDomain(req *api.ListAccessibleObjectsReq) (*domain.ListAccessibleObjectsReq, error) {
+	envAttributes := make([]domain.Attribute, len(req.EnvAttributes))
+	for i, attr := range req.EnvAttributes {
+		domainAttr, err := AttributeToDomain(attr)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		envAttributes[i] = *domainAttr
+	}
+	sub, err := ResourceToDomain(req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListAccessibleObjectsReq{
+		Subject:        *sub,
+		PermissionName: req.PermissionName,
+		Env:            envAttributes,
+		ObjectKind:     req.ObjectKind,
+		PageSize:       int(req.PageSize),
+		PageToken:      req.PageToken,
+	}, nil
+}
+
+func ListAccessibleObjectsRespFromDomain(resp *domain.ListAccessibleObjectsResp) (*api.ListAccessibleObjectsResp, error) {
+	objects := make([]*api.Resource, 0, len(resp.Objects))
+	for _, domainObj := range resp.Objects {
+		obj, err := ResourceFromDomain(&domainObj)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	return &api.ListAccessibleObjectsResp{
+		Objects:       objects,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package proto
+
+import (
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+)
+
+func testAuthorizationBatch(checks int) *api.AuthorizationBatchReq {
+	req := &api.AuthorizationBatchReq{Checks: make([]*api.AuthorizationReq, checks)}
+	for i := range req.Checks {
+		req.Checks[i] = &api.AuthorizationReq{
+			Subject:        &api.Resource{Id: "1", Kind: "user"},
+			Object:         &api.Resource{Id: "1", Kind: "doc"},
+			PermissionName: "read",
+		}
+	}
+	return req
+}
+
+func TestAuthorizationBatchReqToDomainLimit(t *testing.T) {
+	req, err := AuthorizationBatchReqToDomain(testAuthorizationBatch(domain.MaxAuthorizationBatchChecks))
+	if err != nil {
+		t.Fatalf("expected a full batch to be accepted, got %v", err)
+	}
+	if len(req.Checks) != domain.MaxAuthorizationBatchChecks {
+		t.Errorf("expected %d checks, got %d", domain.MaxAuthorizationBatchChecks, len(req.Checks))
+	}
+
+	_, err = AuthorizationBatchReqToDomain(testAuthorizationBatch(domain.MaxAuthorizationBatchChecks + 1))
+	if kind := domain.KindOf(err); kind != domain.ErrKindInvalidArgument {
+		t.Errorf("expected an invalid argument error, got %v", err)
+	}
+}
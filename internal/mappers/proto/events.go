@@ -0,0 +1,103 @@
+package proto
+
+import (
+	"math"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var eventActions = map[domain.EventAction]api.Event_Action{
+	domain.EventActionCreated: api.Event_CREATED,
+	domain.EventActionDeleted: api.Event_DELETED,
+	domain.EventActionPut:     api.Event_PUT,
+}
+
+var eventEntities = map[api.EventEntity]domain.EventEntity{
+	api.EventEntity_RESOURCE:        domain.EventEntityResource,
+	api.EventEntity_ATTRIBUTE:       domain.EventEntityAttribute,
+	api.EventEntity_INHERITANCE_REL: domain.EventEntityInheritanceRel,
+	api.EventEntity_POLICY:          domain.EventEntityPolicy,
+}
+
+func WatchReqToDomain(req *api.WatchReq) (*domain.WatchReq, error) {
+	entities := make([]domain.EventEntity, len(req.Entities))
+	for i, entity := range req.Entities {
+		domainEntity, ok := eventEntities[entity]
+		if !ok {
+			return nil, domain.NewError(domain.ErrKindInvalidArgument, "unknown event entity")
+		}
+		entities[i] = domainEntity
+	}
+	var fromRevision *int64
+	if req.FromRevision != nil {
+		if *req.FromRevision > math.MaxInt64 {
+			return nil, domain.NewError(domain.ErrKindInvalidArgument, "revision out of range")
+		}
+		revision := int64(*req.FromRevision)
+		fromRevision = &revision
+	}
+	return &domain.WatchReq{
+		Kind:         req.Kind,
+		NamePrefix:   req.NamePrefix,
+		Entities:     entities,
+		FromRevision: fromRevision,
+	}, nil
+}
+
+func EventFromDomain(event *domain.Event) (*api.Event, error) {
+	action, ok := eventActions[event.Action]
+	if !ok {
+		return nil, domain.NewError(domain.ErrKindInternal, "unknown event action")
+	}
+	resp := &api.Event{
+		Id:        event.Id,
+		Revision:  uint64(event.Revision),
+		Timestamp: timestamppb.New(event.Timestamp),
+		Action:    action,
+	}
+	switch event.Entity {
+	case domain.EventEntityResource:
+		resource, err := ResourceFromDomain(&event.Resource)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entity = &api.Event_Resource{Resource: &api.ResourceEvent{Resource: resource}}
+	case domain.EventEntityAttribute:
+		resource, err := ResourceFromDomain(&event.Resource)
+		if err != nil {
+			return nil, err
+		}
+		attrEvent := &api.AttributeEvent{
+			Resource:    resource,
+			AttributeId: &api.AttributeId{Name: event.AttributeId.Name()},
+		}
+		if event.Attribute != nil {
+			attrEvent.Attribute, err = AttributeFromDomain(event.Attribute)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.Entity = &api.Event_Attribute{Attribute: attrEvent}
+	case domain.EventEntityInheritanceRel:
+		from, err := ResourceFromDomain(&event.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := ResourceFromDomain(&event.To)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entity = &api.Event_InheritanceRel{InheritanceRel: &api.InheritanceRelEvent{From: from, To: to}}
+	case domain.EventEntityPolicy:
+		policy, err := PolicyFromDomain(&event.Policy)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entity = &api.Event_Policy{Policy: &api.PolicyEvent{Policy: policy}}
+	default:
+		return nil, domain.NewError(domain.ErrKindInternal, "unknown event entity")
+	}
+	return resp, nil
+}
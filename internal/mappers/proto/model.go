@@ -1,18 +1,27 @@
 package proto
 
 import (
-	"errors"
+	"fmt"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/pkg/api"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func AttributeIdToDomain(id *api.AttributeId) (*domain.AttributeId, error) {
+	if id == nil {
+		return nil, domain.NewError(domain.ErrKindInvalidArgument, "attribute id missing")
+	}
 	return domain.NewAttributeId(id.Name)
 }
 
 func AttributeToDomain(attr *api.Attribute) (*domain.Attribute, error) {
+	if attr == nil {
+		return nil, domain.NewError(domain.ErrKindInvalidArgument, "attribute missing")
+	}
 	value, err := AttributeValueToDomain(attr)
 	if err != nil {
 		return nil, err
@@ -30,36 +39,160 @@ func AttributeValueToDomain(attr *api.Attribute) (interface{}, error) {
 		var value api.Int64Attribute
 		err := proto.Unmarshal(attr.Value, &value)
 		if err != nil {
-			return nil, err
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
 		}
 		return value.Value, nil
 	case api.Attribute_FLOAT64:
 		var value api.Float64Attribute
 		err := proto.Unmarshal(attr.Value, &value)
 		if err != nil {
-			return nil, err
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
 		}
 		return value.Value, nil
 	case api.Attribute_STRING:
 		var value api.StringAttribute
 		err := proto.Unmarshal(attr.Value, &value)
 		if err != nil {
-			return nil, err
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
 		}
 		return value.Value, nil
 	case api.Attribute_BOOL:
 		var value api.BoolAttribute
 		err := proto.Unmarshal(attr.Value, &value)
 		if err != nil {
-			return nil, err
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		return value.Value, nil
+	case api.Attribute_STRING_LIST:
+		var value api.StringListAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		if value.Value == nil {
+			return []string{}, nil
+		}
+		return value.Value, nil
+	case api.Attribute_INT64_LIST:
+		var value api.Int64ListAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		if value.Value == nil {
+			return []int64{}, nil
 		}
 		return value.Value, nil
+	case api.Attribute_TIMESTAMP:
+		var value api.TimestampAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		if err := value.Value.CheckValid(); err != nil {
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		return value.Value.AsTime(), nil
+	case api.Attribute_DURATION:
+		var value api.DurationAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		if err := value.Value.CheckValid(); err != nil {
+			return nil, domain.WrapError(domain.ErrKindInvalidArgument, err)
+		}
+		return value.Value.AsDuration(), nil
+	default:
+		return nil, domain.NewError(domain.ErrKindInvalidArgument, "unknown kind")
+	}
+}
+
+func AttributeFromDomain(attr *domain.Attribute) (*api.Attribute, error) {
+	value, err := AttributeValueFromDomain(attr)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Attribute{
+		Id: &api.AttributeId{
+			Name: attr.Name(),
+		},
+		Kind:  api.Attribute_AttributeKind(attr.Kind()),
+		Value: value,
+	}, nil
+}
+
+// AttributeValueFromDomain fails with an internal error if the value doesn't match the attribute's kind
+func AttributeValueFromDomain(attr *domain.Attribute) ([]byte, error) {
+	var value proto.Message
+	switch attr.Kind() {
+	case domain.Int64:
+		v, err := attributeValue[int64](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.Int64Attribute{Value: v}
+	case domain.Float64:
+		v, err := attributeValue[float64](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.Float64Attribute{Value: v}
+	case domain.String:
+		v, err := attributeValue[string](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.StringAttribute{Value: v}
+	case domain.Bool:
+		v, err := attributeValue[bool](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.BoolAttribute{Value: v}
+	case domain.StringList:
+		v, err := attributeValue[[]string](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.StringListAttribute{Value: v}
+	case domain.Int64List:
+		v, err := attributeValue[[]int64](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.Int64ListAttribute{Value: v}
+	case domain.Timestamp:
+		v, err := attributeValue[time.Time](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.TimestampAttribute{Value: timestamppb.New(v)}
+	case domain.Duration:
+		v, err := attributeValue[time.Duration](attr)
+		if err != nil {
+			return nil, err
+		}
+		value = &api.DurationAttribute{Value: durationpb.New(v)}
 	default:
-		return nil, errors.New("unknown kind")
+		return nil, domain.NewError(domain.ErrKindInternal, "unknown kind")
 	}
+	return proto.Marshal(value)
+}
+
+func attributeValue[T any](attr *domain.Attribute) (T, error) {
+	value, ok := attr.Value().(T)
+	if !ok {
+		return value, domain.NewError(domain.ErrKindInternal,
+			fmt.Sprintf("attribute %s holds a value of type %T", attr.Name(), attr.Value()))
+	}
+	return value, nil
 }
 
 func ResourceToDomain(res *api.Resource) (*domain.Resource, error) {
+	if res == nil {
+		return nil, domain.NewError(domain.ErrKindInvalidArgument, "resource missing")
+	}
 	return domain.NewResource(res.Id, res.Kind)
 }
 
@@ -71,7 +204,10 @@ func ResourceFromDomain(res *domain.Resource) (*api.Resource, error) {
 }
 
 func PermissionToDomain(perm *api.Permission) (*domain.Permission, error) {
-	condition, err := domain.NewCondition(perm.Condition.Expression)
+	if perm == nil {
+		return nil, domain.NewError(domain.ErrKindInvalidArgument, "permission missing")
+	}
+	condition, err := domain.NewCondition(perm.GetCondition().GetExpression())
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +216,38 @@ func PermissionToDomain(perm *api.Permission) (*domain.Permission, error) {
 		*condition)
 }
 
+func PermissionFromDomain(perm *domain.Permission) (*api.Permission, error) {
+	return &api.Permission{
+		Name: perm.Name(),
+		Kind: api.Permission_PermissionKind(perm.Kind()),
+		Condition: &api.Condition{
+			Expression: perm.Condition().Expression(),
+		},
+	}, nil
+}
+
+// PolicyFromDomain maps a permission along with its scope
+func PolicyFromDomain(perm *domain.Permission) (*api.Policy, error) {
+	permission, err := PermissionFromDomain(perm)
+	if err != nil {
+		return nil, err
+	}
+	scope := perm.Scope()
+	subScope, err := ResourceFromDomain(&scope.Subject)
+	if err != nil {
+		return nil, err
+	}
+	objScope, err := ResourceFromDomain(&scope.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Policy{
+		SubjectScope: subScope,
+		ObjectScope:  objScope,
+		Permission:   permission,
+	}, nil
+}
+
 func GrantedPermissionFromDomain(perm *domain.GrantedPermission) (*api.GrantedPermission, error) {
 	object, err := ResourceFromDomain(&perm.Object)
 	if err != nil {
@@ -1,6 +1,8 @@
 package neo4j
 
 import (
+	"fmt"
+
 	"github.com/c12s/oort/internal/domain"
 )
 
@@ -8,6 +10,10 @@ type CypherFactory interface {
 	createResource(req domain.CreateResourceReq) (string, map[string]interface{})
 	deleteResource(req domain.DeleteResourceReq) (string, map[string]interface{})
 	getResource(req domain.GetResourceReq) (string, map[string]interface{})
+	getResourcesWithAttributes(req domain.GetResourcesWithAttributesReq) (string, map[string]interface{})
+	getResources(req domain.GetResourcesReq) (string, map[string]interface{})
+	getChildren(names []string) (string, map[string]interface{})
+	getAncestors(names []string) (string, map[string]interface{})
 	putAttribute(req domain.PutAttributeReq) (string, map[string]interface{})
 	deleteAttribute(req domain.DeleteAttributeReq) (string, map[string]interface{})
 	createInheritanceRel(req domain.CreateInheritanceRelReq) (string, map[string]interface{})
@@ -15,7 +21,20 @@ type CypherFactory interface {
 	createPolicy(req domain.CreatePolicyReq) (string, map[string]interface{})
 	deletePolicy(req domain.DeletePolicyReq) (string, map[string]interface{})
 	getEffectivePermissionsWithPriority(req domain.GetPermissionHierarchyReq) (string, map[string]interface{})
+	getEffectivePermissionsWithPriorityBatch(req domain.GetPermissionHierarchiesReq) (string, map[string]interface{})
 	getApplicablePolicies(req domain.GetApplicablePoliciesReq) (string, map[string]interface{})
+	getPolicies(req domain.ListPoliciesReq, afterSequence int64) (string, map[string]interface{})
+	getInheritedPolicies(req domain.GetPoliciesForResourceReq) (string, map[string]interface{})
+	getSubjectCandidates(req domain.GetSubjectCandidatesReq) (string, map[string]interface{})
+	getObjectCandidates(req domain.GetObjectCandidatesReq) (string, map[string]interface{})
+	createOutboxEvent(event domain.Event) (string, map[string]interface{})
+	getEvents(req domain.GetEventsReq) (string, map[string]interface{})
+	getRevision() (string, map[string]interface{})
+	markEventsDelivered(req domain.MarkEventsDeliveredReq) (string, map[string]interface{})
+	pruneEvents(req domain.PruneEventsReq) (string, map[string]interface{})
+	createAuditRecord(auditId, actor string, actorVerified bool, event domain.Event) (string, map[string]interface{})
+	completeAuditRecord(auditId string, event domain.Event) (string, map[string]interface{})
+	getAuditRecords(req domain.ListAuditRecordsReq, afterSequence int64) (string, map[string]interface{})
 }
 
 type simpleCypherFactory struct {
@@ -58,6 +77,7 @@ CALL {
     WITH r
     DETACH DELETE r
 }
+RETURN count(*) AS deleted
 `
 
 func (f simpleCypherFactory) deleteResource(req domain.DeleteResourceReq) (string, map[string]interface{}) {
@@ -78,6 +98,69 @@ func (f simpleCypherFactory) getResource(req domain.GetResourceReq) (string, map
 			"name": req.Resource.Name()}
 }
 
+const ncGetResourcesWithAttributesCypher = `
+UNWIND $names AS name
+MATCH (resource:Resource{name: name})
+OPTIONAL MATCH (attr:Attribute)<-[:HAS]-(resource)
+RETURN resource.name, collect(properties(attr)) as attrs
+`
+
+func (f simpleCypherFactory) getResourcesWithAttributes(req domain.GetResourcesWithAttributesReq) (string, map[string]interface{}) {
+	names := make([]string, len(req.Resources))
+	for i, resource := range req.Resources {
+		names[i] = resource.Name()
+	}
+	return ncGetResourcesWithAttributesCypher,
+		map[string]interface{}{
+			"names": names}
+}
+
+const ncGetResourcesCypher = `
+MATCH (resource:Resource)
+WHERE resource.name > $after AND resource.name <> $rootName AND ($kind = "" OR resource.name STARTS WITH $kind + "/")
+RETURN resource.name AS name
+ORDER BY name
+LIMIT $limit
+`
+
+func (f simpleCypherFactory) getResources(req domain.GetResourcesReq) (string, map[string]interface{}) {
+	return ncGetResourcesCypher,
+		map[string]interface{}{
+			"kind":     req.Kind,
+			"after":    req.After,
+			"rootName": domain.RootResource.Name(),
+			"limit":    req.Limit}
+}
+
+// one level of a breadth first traversal, every resource is returned even if it has no children
+const ncGetChildrenCypher = `
+UNWIND $names AS name
+MATCH (resource:Resource{name: name})
+OPTIONAL MATCH (resource)<-[:INHERITS_FROM]-(child:Resource)
+RETURN resource.name, child.name
+`
+
+func (f simpleCypherFactory) getChildren(names []string) (string, map[string]interface{}) {
+	return ncGetChildrenCypher,
+		map[string]interface{}{
+			"names": names}
+}
+
+const ncGetAncestorsCypher = `
+UNWIND $names AS name
+MATCH (resource:Resource{name: name})
+OPTIONAL MATCH (resource)-[:INHERITS_FROM]->(parent:Resource)
+WHERE parent.name <> $rootName
+RETURN resource.name, parent.name
+`
+
+func (f simpleCypherFactory) getAncestors(names []string) (string, map[string]interface{}) {
+	return ncGetAncestorsCypher,
+		map[string]interface{}{
+			"names":    names,
+			"rootName": domain.RootResource.Name()}
+}
+
 const ncPutAttributeCypher = `
 MERGE (r:Resource{name: $name})
 MERGE (root:Resource{name: $rootName})
@@ -93,12 +176,13 @@ func (f simpleCypherFactory) putAttribute(req domain.PutAttributeReq) (string, m
 			"rootName":  domain.RootResource.Name(),
 			"attrName":  req.Attribute.Name(),
 			"attrKind":  req.Attribute.Kind(),
-			"attrValue": req.Attribute.Value()}
+			"attrValue": neo4jAttributeValue(req.Attribute)}
 }
 
 const ncDeleteAttributeCypher = `
 MATCH ((:Resource{name: $name})-[:HAS]->(a:Attribute{name: $attrName}))
 DETACH DELETE a
+RETURN count(*) AS deleted
 `
 
 func (f simpleCypherFactory) deleteAttribute(req domain.DeleteAttributeReq) (string, map[string]interface{}) {
@@ -115,10 +199,13 @@ MERGE (root:Resource{name: $rootName})
 MERGE (from)-[:INHERITS_FROM]->(root)
 MERGE (to)-[:INHERITS_FROM]->(root)
 WITH from, to
-MATCH (f) WHERE ID(f) = ID(from)
-MATCH (t) WHERE ID(t) = ID(to)
-AND NOT (t)-[:INHERITS_FROM]->(f) AND NOT (f)-[:INHERITS_FROM*]->(t)
-CREATE (t)-[:INHERITS_FROM]->(f)
+WITH from, to,
+	exists((to)-[:INHERITS_FROM]->(from)) AS relExists,
+	from = to OR exists((from)-[:INHERITS_FROM*]->(to)) AS cycle
+FOREACH (i IN CASE WHEN NOT relExists AND NOT cycle THEN [1] ELSE [] END |
+	CREATE (to)-[:INHERITS_FROM]->(from)
+)
+RETURN relExists, cycle
 `
 
 func (f simpleCypherFactory) createInheritanceRel(req domain.CreateInheritanceRelReq) (string, map[string]interface{}) {
@@ -132,6 +219,7 @@ func (f simpleCypherFactory) createInheritanceRel(req domain.CreateInheritanceRe
 const ncDeleteInheritanceRelCypher = `
 MATCH (:Resource{name: $toName})-[rel:INHERITS_FROM]->(:Resource{name: $fromName})
 DELETE rel
+RETURN count(*) AS deleted
 `
 
 func (f simpleCypherFactory) deleteInheritanceRel(req domain.DeleteInheritanceRelReq) (string, map[string]interface{}) {
@@ -141,13 +229,18 @@ func (f simpleCypherFactory) deleteInheritanceRel(req domain.DeleteInheritanceRe
 			"toName":   req.To.Name()}
 }
 
+// new permissions are numbered so that they can be paged in creation order, the sequence
+// is incremented before the permission is merged to lock it until the transaction commits
 const ncCreatePermissionCypher = `
+MERGE (seq:Revision{name: "policies"})
+SET seq.value = coalesce(seq.value, 0) + 1
 MERGE (sub:Resource{name: $subName})
 MERGE (obj:Resource{name: $objName})
 MERGE (root:Resource{name: $rootName})
 MERGE (sub)-[:INHERITS_FROM]->(root)
 MERGE (obj)-[:INHERITS_FROM]->(root)
 MERGE ((sub)-[:HAS]->(p:Permission{name: $permName, kind: $permKind})-[:ON]->(obj))
+ON CREATE SET p.sequence = seq.value
 SET p.condition = $permCond
 `
 
@@ -167,6 +260,7 @@ MATCH (sub:Resource{name: $subName})
 MATCH (obj:Resource{name: $objName})
 MATCH ((sub)-[:HAS]->(p:Permission{name: $permName, kind: $permKind})-[:ON]->(obj))
 DETACH DELETE p
+RETURN count(*) AS deleted
 `
 
 func (f simpleCypherFactory) deletePolicy(req domain.DeletePolicyReq) (string, map[string]interface{}) {
@@ -196,7 +290,7 @@ CALL {
 	ORDER BY objPriority ASC
 	LIMIT 1
 }
-RETURN p.name, p.kind, p.condition, subPriority, objPriority
+RETURN p.name, p.kind, p.condition, subPriority, objPriority, subParent.name, objParent.name
 `
 
 func (f simpleCypherFactory) getEffectivePermissionsWithPriority(req domain.GetPermissionHierarchyReq) (string, map[string]interface{}) {
@@ -207,6 +301,48 @@ func (f simpleCypherFactory) getEffectivePermissionsWithPriority(req domain.GetP
 			"permName": req.PermissionName}
 }
 
+// the permissions of each check are matched as in ncGetPermissionsCypher and returned along with the check's index
+const ncGetPermissionsBatchCypher = `
+UNWIND range(0, size($checks) - 1) AS i
+CALL {
+	WITH i
+	MATCH (sub:Resource{name: $checks[i].subName})-[:INHERITS_FROM*0..]->(subParent:Resource)-[:HAS]->
+	(p:Permission{name: $checks[i].permName})-[:ON]->(objParent:Resource)<-[:INHERITS_FROM*0..]-(obj:Resource{name: $checks[i].objName})
+	WITH p, sub, subParent, obj, objParent
+	CALL {
+		WITH sub, subParent
+		MATCH path=(sub)-[:INHERITS_FROM*0..100]->(subParent)
+		RETURN -length(path) AS subPriority
+		ORDER BY subPriority ASC
+		LIMIT 1
+	}
+	CALL {
+		WITH obj, objParent
+		MATCH path=(obj)-[:INHERITS_FROM*0..100]->(objParent)
+		RETURN -length(path) AS objPriority
+		ORDER BY objPriority ASC
+		LIMIT 1
+	}
+	RETURN p.name AS permName, p.kind AS permKind, p.condition AS permCond, subPriority, objPriority,
+	subParent.name AS subScope, objParent.name AS objScope
+}
+RETURN i, permName, permKind, permCond, subPriority, objPriority, subScope, objScope
+`
+
+func (f simpleCypherFactory) getEffectivePermissionsWithPriorityBatch(req domain.GetPermissionHierarchiesReq) (string, map[string]interface{}) {
+	checks := make([]map[string]interface{}, len(req.Checks))
+	for i, check := range req.Checks {
+		checks[i] = map[string]interface{}{
+			"subName":  check.Subject.Name(),
+			"objName":  check.Object.Name(),
+			"permName": check.PermissionName,
+		}
+	}
+	return ncGetPermissionsBatchCypher,
+		map[string]interface{}{
+			"checks": checks}
+}
+
 const ncGetApplicablePoliciesCypher = `
 MATCH (sub:Resource{name: $subName})-[:INHERITS_FROM*0..]->(subParent:Resource)-[:HAS]->
 (p:Permission)-[:ON]->(objParent:Resource)<-[:INHERITS_FROM*0..]-(obj:Resource)
@@ -220,6 +356,218 @@ func (f simpleCypherFactory) getApplicablePolicies(req domain.GetApplicablePolic
 		}
 }
 
+const ncGetPoliciesCypher = `
+MATCH (sub:Resource)-[:HAS]->(p:Permission)-[:ON]->(obj:Resource)
+WHERE p.sequence > $afterSequence
+AND ($subName = "" OR sub.name = $subName)
+AND ($objName = "" OR obj.name = $objName)
+AND ($permName = "" OR p.name = $permName)
+AND (size($permKinds) = 0 OR p.kind IN $permKinds)
+RETURN p.name, p.kind, p.condition, sub.name, obj.name, p.sequence AS sequence
+ORDER BY sequence
+LIMIT $limit
+`
+
+func (f simpleCypherFactory) getPolicies(req domain.ListPoliciesReq, afterSequence int64) (string, map[string]interface{}) {
+	subName := ""
+	if req.SubjectScope != nil {
+		subName = req.SubjectScope.Name()
+	}
+	objName := ""
+	if req.ObjectScope != nil {
+		objName = req.ObjectScope.Name()
+	}
+	permKinds := make([]int64, len(req.PermissionKinds))
+	for i, kind := range req.PermissionKinds {
+		permKinds[i] = int64(kind)
+	}
+	return ncGetPoliciesCypher,
+		map[string]interface{}{
+			"afterSequence": afterSequence,
+			"subName":       subName,
+			"objName":       objName,
+			"permName":      req.PermissionName,
+			"permKinds":     permKinds,
+			"limit":         req.PageSize,
+		}
+}
+
+const ncGetInheritedPoliciesCypher = `
+MATCH path=(:Resource{name: $name})-[:INHERITS_FROM*0..]->(scope:Resource)
+WITH scope, min(length(path)) AS distance
+CALL {
+	WITH scope
+	MATCH (scope)-[:HAS]->(p:Permission)-[:ON]->(obj:Resource)
+	RETURN p, scope AS sub, obj, true AS asSubject
+	UNION
+	WITH scope
+	MATCH (sub:Resource)-[:HAS]->(p:Permission)-[:ON]->(scope)
+	RETURN p, sub, scope AS obj, false AS asSubject
+}
+RETURN p.name, p.kind, p.condition, sub.name, obj.name, asSubject, distance
+ORDER BY distance, asSubject DESC
+`
+
+func (f simpleCypherFactory) getInheritedPolicies(req domain.GetPoliciesForResourceReq) (string, map[string]interface{}) {
+	return ncGetInheritedPoliciesCypher,
+		map[string]interface{}{
+			"name": req.Resource.Name(),
+		}
+}
+
+const ncGetSubjectCandidatesCypher = `
+MATCH (obj:Resource{name: $objName})-[:INHERITS_FROM*0..]->(objParent:Resource)<-[:ON]-
+(p:Permission{name: $permName})<-[:HAS]-(subParent:Resource)
+WITH DISTINCT subParent
+MATCH (sub:Resource)-[:INHERITS_FROM*0..]->(subParent)
+WHERE sub.name > $after AND sub.name <> $rootName AND ($subKind = "" OR sub.name STARTS WITH $subKind + "/")
+RETURN DISTINCT sub.name AS name
+ORDER BY name
+LIMIT $limit
+`
+
+func (f simpleCypherFactory) getSubjectCandidates(req domain.GetSubjectCandidatesReq) (string, map[string]interface{}) {
+	return ncGetSubjectCandidatesCypher,
+		map[string]interface{}{
+			"objName":  req.Object.Name(),
+			"permName": req.PermissionName,
+			"subKind":  req.SubjectKind,
+			"after":    req.After,
+			"rootName": domain.RootResource.Name(),
+			"limit":    req.Limit,
+		}
+}
+
+const ncGetObjectCandidatesCypher = `
+MATCH (sub:Resource{name: $subName})-[:INHERITS_FROM*0..]->(subParent:Resource)-[:HAS]->
+(p:Permission{name: $permName})-[:ON]->(objParent:Resource)
+WITH DISTINCT objParent
+MATCH (obj:Resource)-[:INHERITS_FROM*0..]->(objParent)
+WHERE obj.name > $after AND obj.name <> $rootName AND ($objKind = "" OR obj.name STARTS WITH $objKind + "/")
+RETURN DISTINCT obj.name AS name
+ORDER BY name
+LIMIT $limit
+`
+
+func (f simpleCypherFactory) getObjectCandidates(req domain.GetObjectCandidatesReq) (string, map[string]interface{}) {
+	return ncGetObjectCandidatesCypher,
+		map[string]interface{}{
+			"subName":  req.Subject.Name(),
+			"permName": req.PermissionName,
+			"objKind":  req.ObjectKind,
+			"after":    req.After,
+			"rootName": domain.RootResource.Name(),
+			"limit":    req.Limit,
+		}
+}
+
+// the revision node stays locked until the transaction commits, so events
+// become visible in the order of their revisions
+const ncCreateOutboxEventCypher = `
+MERGE (r:Revision{name: "events"})
+SET r.value = coalesce(r.value, 0) + 1
+CREATE (e:OutboxEvent{id: randomUUID(), revision: r.value, timestamp: datetime(), delivered: false,
+entity: $entity, action: $action, resource: $resource, attrName: $attrName, attrKind: $attrKind, attrValue: $attrValue,
+fromName: $fromName, toName: $toName, permName: $permName, permKind: $permKind, permCond: $permCond,
+subScope: $subScope, objScope: $objScope})
+`
+
+func (f simpleCypherFactory) createOutboxEvent(event domain.Event) (string, map[string]interface{}) {
+	return ncCreateOutboxEventCypher, eventParams(event)
+}
+
+// eventParams sets only the properties related to the event's entity, the rest are null and not stored
+func eventParams(event domain.Event) map[string]interface{} {
+	params := map[string]interface{}{
+		"entity":    string(event.Entity),
+		"action":    string(event.Action),
+		"resource":  nil,
+		"attrName":  nil,
+		"attrKind":  nil,
+		"attrValue": nil,
+		"fromName":  nil,
+		"toName":    nil,
+		"permName":  nil,
+		"permKind":  nil,
+		"permCond":  nil,
+		"subScope":  nil,
+		"objScope":  nil,
+	}
+	switch event.Entity {
+	case domain.EventEntityResource:
+		params["resource"] = event.Resource.Name()
+	case domain.EventEntityAttribute:
+		params["resource"] = event.Resource.Name()
+		params["attrName"] = event.AttributeId.Name()
+		if event.Attribute != nil {
+			params["attrKind"] = event.Attribute.Kind()
+			params["attrValue"] = neo4jAttributeValue(*event.Attribute)
+		}
+	case domain.EventEntityInheritanceRel:
+		params["fromName"] = event.From.Name()
+		params["toName"] = event.To.Name()
+	case domain.EventEntityPolicy:
+		params["permName"] = event.Policy.Name()
+		params["permKind"] = event.Policy.Kind()
+		params["permCond"] = event.Policy.Condition().Expression()
+		params["subScope"] = event.Policy.Scope().Subject.Name()
+		params["objScope"] = event.Policy.Scope().Object.Name()
+	}
+	return params
+}
+
+const ncGetEventsCypher = `
+MATCH (e:OutboxEvent)
+WHERE e.revision > $afterRevision AND (NOT $undeliveredOnly OR e.delivered = false)
+RETURN properties(e)
+ORDER BY e.revision
+LIMIT $limit
+`
+
+func (f simpleCypherFactory) getEvents(req domain.GetEventsReq) (string, map[string]interface{}) {
+	return ncGetEventsCypher,
+		map[string]interface{}{
+			"afterRevision":   req.AfterRevision,
+			"undeliveredOnly": req.UndeliveredOnly,
+			"limit":           req.Limit,
+		}
+}
+
+const ncGetRevisionCypher = `
+OPTIONAL MATCH (r:Revision{name: "events"})
+RETURN coalesce(r.value, 0)
+`
+
+func (f simpleCypherFactory) getRevision() (string, map[string]interface{}) {
+	return ncGetRevisionCypher, map[string]interface{}{}
+}
+
+const ncMarkEventsDeliveredCypher = `
+MATCH (e:OutboxEvent)
+WHERE e.id IN $ids
+SET e.delivered = true, e.deliveredAt = datetime()
+`
+
+func (f simpleCypherFactory) markEventsDelivered(req domain.MarkEventsDeliveredReq) (string, map[string]interface{}) {
+	return ncMarkEventsDeliveredCypher,
+		map[string]interface{}{
+			"ids": req.Ids,
+		}
+}
+
+const ncPruneEventsCypher = `
+MATCH (e:OutboxEvent{delivered: true})
+WHERE e.deliveredAt < $deliveredBefore
+DELETE e
+`
+
+func (f simpleCypherFactory) pruneEvents(req domain.PruneEventsReq) (string, map[string]interface{}) {
+	return ncPruneEventsCypher,
+		map[string]interface{}{
+			"deliveredBefore": req.DeliveredBefore,
+		}
+}
+
 // todo: sredi ovo
 //type cachedPermsCypherFactory struct {
 //}
@@ -512,3 +860,122 @@ func (f simpleCypherFactory) getApplicablePolicies(req domain.GetApplicablePolic
 //			"objName":  req.Object.Name(),
 //			"permName": req.PermissionName}
 //}
+
+// audit snapshots copy the state of the affected entity, the relationship type is
+// formatted in and tells whether the snapshot was taken BEFORE or AFTER the operation
+var ncAuditSnapshotCyphers = map[domain.EventEntity]string{
+	domain.EventEntityResource: `
+WITH rec
+OPTIONAL MATCH (r:Resource{name: $resource})
+OPTIONAL MATCH (r)-[:HAS]->(a:Attribute)
+WITH rec, r, collect(a) AS attrs
+FOREACH (_ IN CASE WHEN r IS NULL THEN [] ELSE [1] END |
+	CREATE (rec)-[:%s]->(s:AuditSnapshot{resource: r.name})
+	FOREACH (a IN attrs |
+		CREATE (s)-[:HAS]->(c:AuditAttribute)
+		SET c = properties(a)
+	)
+)
+`,
+	domain.EventEntityAttribute: `
+WITH rec
+OPTIONAL MATCH (:Resource{name: $resource})-[:HAS]->(a:Attribute{name: $attrName})
+FOREACH (_ IN CASE WHEN a IS NULL THEN [] ELSE [1] END |
+	CREATE (rec)-[:%s]->(:AuditSnapshot{resource: $resource})-[:HAS]->(c:AuditAttribute)
+	SET c = properties(a)
+)
+`,
+	domain.EventEntityInheritanceRel: `
+WITH rec
+OPTIONAL MATCH (:Resource{name: $toName})-[rel:INHERITS_FROM]->(:Resource{name: $fromName})
+FOREACH (_ IN CASE WHEN rel IS NULL THEN [] ELSE [1] END |
+	CREATE (rec)-[:%s]->(:AuditSnapshot{fromName: $fromName, toName: $toName})
+)
+`,
+	domain.EventEntityPolicy: `
+WITH rec
+OPTIONAL MATCH (:Resource{name: $subScope})-[:HAS]->(p:Permission{name: $permName, kind: $permKind})-[:ON]->(:Resource{name: $objScope})
+FOREACH (_ IN CASE WHEN p IS NULL THEN [] ELSE [1] END |
+	CREATE (rec)-[:%s]->(:AuditSnapshot{permName: p.name, permKind: p.kind, permCond: p.condition,
+		subScope: $subScope, objScope: $objScope})
+)
+`,
+}
+
+// the record keeps the names of all resources the operation refers to, so that it can be found by any of them.
+// Records are numbered like events, the sequence node stays locked until the transaction commits,
+// so records become visible in the order of their sequence numbers and can be paged by them.
+const ncCreateAuditRecordCypher = `
+MERGE (seq:Revision{name: "audit"})
+SET seq.value = coalesce(seq.value, 0) + 1
+CREATE (rec:AuditRecord{id: $auditId, sequence: seq.value, actor: $actor, actorVerified: $actorVerified,
+timestamp: datetime(), entity: $entity, action: $action, resources: $resources})
+`
+
+// createAuditRecord creates the record along with the snapshot taken before the operation
+func (f simpleCypherFactory) createAuditRecord(auditId, actor string, actorVerified bool, event domain.Event) (string, map[string]interface{}) {
+	params := eventParams(event)
+	params["auditId"] = auditId
+	params["actor"] = actor
+	params["actorVerified"] = actorVerified
+	resources := make([]string, 0)
+	for _, resource := range event.Resources() {
+		resources = append(resources, resource.Name())
+	}
+	params["resources"] = resources
+	return ncCreateAuditRecordCypher + fmt.Sprintf(ncAuditSnapshotCyphers[event.Entity], "BEFORE"), params
+}
+
+const ncMatchAuditRecordCypher = `
+MATCH (rec:AuditRecord{id: $auditId})
+`
+
+// completeAuditRecord adds the snapshot taken after the operation
+func (f simpleCypherFactory) completeAuditRecord(auditId string, event domain.Event) (string, map[string]interface{}) {
+	params := eventParams(event)
+	params["auditId"] = auditId
+	return ncMatchAuditRecordCypher + fmt.Sprintf(ncAuditSnapshotCyphers[event.Entity], "AFTER"), params
+}
+
+const ncGetAuditRecordsCypher = `
+MATCH (rec:AuditRecord)
+WHERE rec.sequence > $afterSequence
+AND ($actor = "" OR rec.actor = $actor)
+AND ($resource = "" OR $resource IN rec.resources)
+AND ($from IS NULL OR rec.timestamp >= $from)
+AND ($to IS NULL OR rec.timestamp < $to)
+WITH rec
+ORDER BY rec.sequence
+LIMIT $limit
+OPTIONAL MATCH (rec)-[:BEFORE]->(before:AuditSnapshot)
+OPTIONAL MATCH (before)-[:HAS]->(beforeAttr:AuditAttribute)
+WITH rec, before, collect(properties(beforeAttr)) AS beforeAttrs
+OPTIONAL MATCH (rec)-[:AFTER]->(after:AuditSnapshot)
+OPTIONAL MATCH (after)-[:HAS]->(afterAttr:AuditAttribute)
+WITH rec, before, beforeAttrs, after, collect(properties(afterAttr)) AS afterAttrs
+RETURN rec.sequence, properties(rec), properties(before), beforeAttrs, properties(after), afterAttrs
+ORDER BY rec.sequence
+`
+
+func (f simpleCypherFactory) getAuditRecords(req domain.ListAuditRecordsReq, afterSequence int64) (string, map[string]interface{}) {
+	resource := ""
+	if req.Resource != nil {
+		resource = req.Resource.Name()
+	}
+	var from, to interface{}
+	if !req.From.IsZero() {
+		from = req.From
+	}
+	if !req.To.IsZero() {
+		to = req.To
+	}
+	return ncGetAuditRecordsCypher,
+		map[string]interface{}{
+			"afterSequence": afterSequence,
+			"actor":         req.Actor,
+			"resource":      resource,
+			"from":          from,
+			"to":            to,
+			"limit":         req.PageSize,
+		}
+}
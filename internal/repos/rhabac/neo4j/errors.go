@@ -0,0 +1,45 @@
+package neo4j
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// classifyError assigns a domain error kind to driver and mapping errors. Errors that
+// already have a kind are returned unchanged.
+func classifyError(err error) error {
+	if err == nil || domain.KindOf(err) != domain.ErrKindUnknown {
+		return err
+	}
+	var connectivityErr *neo4j.ConnectivityError
+	if errors.As(err, &connectivityErr) {
+		return domain.WrapError(domain.ErrKindUnavailable, err)
+	}
+	var txLimitErr *neo4j.TransactionExecutionLimit
+	if errors.As(err, &txLimitErr) {
+		return domain.WrapError(domain.ErrKindUnavailable, err)
+	}
+	var neo4jErr *neo4j.Neo4jError
+	if errors.As(err, &neo4jErr) {
+		return domain.WrapError(neo4jErrorKind(neo4jErr), err)
+	}
+	return domain.WrapError(domain.ErrKindInternal, err)
+}
+
+func neo4jErrorKind(err *neo4j.Neo4jError) domain.ErrorKind {
+	switch {
+	case err.Classification() == "TransientError":
+		return domain.ErrKindUnavailable
+	case err.IsRetriableCluster():
+		return domain.ErrKindUnavailable
+	case strings.HasPrefix(err.Code, "Neo.ClientError.Schema.ConstraintValidationFailed"):
+		return domain.ErrKindConflict
+	case strings.HasPrefix(err.Code, "Neo.ClientError.Database.DatabaseNotFound"):
+		return domain.ErrKindUnavailable
+	default:
+		return domain.ErrKindInternal
+	}
+}
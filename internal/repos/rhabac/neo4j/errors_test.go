@@ -0,0 +1,69 @@
+package neo4j
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type classifyErrorTestCase struct {
+	err         error
+	kind        domain.ErrorKind
+	description string
+}
+
+var classifyErrorTestCases = []classifyErrorTestCase{
+	{
+		err:         domain.ErrResourceNotFound,
+		kind:        domain.ErrKindNotFound,
+		description: "domain error keeps its kind",
+	},
+	{
+		err:         &neo4j.TransactionExecutionLimit{},
+		kind:        domain.ErrKindUnavailable,
+		description: "retries exhausted",
+	},
+	{
+		err:         &neo4j.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected"},
+		kind:        domain.ErrKindUnavailable,
+		description: "transient error",
+	},
+	{
+		err:         &neo4j.Neo4jError{Code: "Neo.ClientError.Cluster.NotALeader"},
+		kind:        domain.ErrKindUnavailable,
+		description: "cluster error",
+	},
+	{
+		err:         &neo4j.Neo4jError{Code: "Neo.ClientError.Schema.ConstraintValidationFailed"},
+		kind:        domain.ErrKindConflict,
+		description: "constraint violated",
+	},
+	{
+		err:         &neo4j.Neo4jError{Code: "Neo.ClientError.Database.DatabaseNotFound"},
+		kind:        domain.ErrKindUnavailable,
+		description: "database not found",
+	},
+	{
+		err:         &neo4j.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError"},
+		kind:        domain.ErrKindInternal,
+		description: "syntax error",
+	},
+	{
+		err:         errors.New("invalid resp format"),
+		kind:        domain.ErrKindInternal,
+		description: "error without a kind",
+	},
+}
+
+func TestClassifyError(t *testing.T) {
+	for _, testCase := range classifyErrorTestCases {
+		if kind := domain.KindOf(classifyError(testCase.err)); kind != testCase.kind {
+			t.Errorf("%s: expected kind %v, got %v", testCase.description, testCase.kind, kind)
+		}
+	}
+	if err := classifyError(nil); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
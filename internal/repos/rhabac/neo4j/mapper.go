@@ -2,108 +2,256 @@ package neo4j
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
 
-func getResource(cypherResult interface{}) *domain.Resource {
-	resource, err := domain.NewResource("", "")
+func getResource(cypherResult interface{}) (*domain.Resource, error) {
+	return getResourceValues(cypherResult.([]*neo4j.Record)[0].Values)
+}
+
+// getResourcesWithAttributes maps records holding a resource name and its attributes, as in getResource
+func getResourcesWithAttributes(cypherResult interface{}) ([]domain.Resource, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	resources := make([]domain.Resource, 0, len(records))
+	for _, record := range records {
+		resource, err := getResourceValues(record.Values)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *resource)
+	}
+	return resources, nil
+}
+
+func getResourceValues(values []interface{}) (*domain.Resource, error) {
+	resource, err := domain.NewResourceFromName(values[0].(string))
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	resource.Attributes = make([]domain.Attribute, 0)
-	attrs := cypherResult.([]*neo4j.Record)[0].Values[1].([]interface{})
+	attrs := values[1].([]interface{})
 	for _, attr := range attrs {
 		a := attr.(map[string]interface{})
 		name := a["name"].(string)
 		kind := domain.AttributeKind(a["kind"].(int64))
-		value := a["value"]
-		if name == "id" {
-			resource.SetId(value.(string))
-		}
-		if name == "kind" {
-			resource.SetKind(value.(string))
+		value, err := attributeValue(name, kind, a["value"])
+		if err != nil {
+			return nil, err
 		}
 		attrId, err := domain.NewAttributeId(name)
 		if err != nil {
-			return nil
+			return nil, err
 		}
 		attribute, err := domain.NewAttribute(*attrId, kind, value)
 		if err != nil {
-			return nil
+			return nil, err
 		}
 		resource.Attributes = append(resource.Attributes, *attribute)
 	}
-	return resource
+	return resource, nil
 }
 
-func getHierarchy(cypherResult interface{}) (domain.PermissionHierarchy, error) {
-	records, ok := cypherResult.([]*neo4j.Record)
-	log.Println(len(records))
+// attributeValue converts list values, which the driver always returns as []interface{},
+// back to the typed slices used by the domain, and native durations to time.Duration.
+// A list element of the wrong type is an internal error, dropping it would change condition results.
+func attributeValue(name string, kind domain.AttributeKind, value interface{}) (interface{}, error) {
+	if duration, ok := value.(neo4j.Duration); ok && kind == domain.Duration {
+		return time.Duration(duration.Days)*24*time.Hour +
+			time.Duration(duration.Seconds)*time.Second +
+			time.Duration(duration.Nanos), nil
+	}
+	list, ok := value.([]interface{})
 	if !ok {
-		return domain.PermissionHierarchy{}, errors.New("invalid resp format")
+		return value, nil
 	}
+	switch kind {
+	case domain.StringList:
+		return listValues[string](name, list)
+	case domain.Int64List:
+		return listValues[int64](name, list)
+	default:
+		return value, nil
+	}
+}
 
-	hierarchy := make(map[domain.PermissionPriority]domain.PermissionObjHierarchy)
-	for _, record := range records {
-		recordElems := record.Values
+func listValues[T any](name string, list []interface{}) ([]T, error) {
+	values := make([]T, 0, len(list))
+	for _, elem := range list {
+		value, ok := elem.(T)
 		if !ok {
-			return domain.PermissionHierarchy{}, errors.New("invalid resp format")
+			return nil, domain.NewError(domain.ErrKindInternal,
+				fmt.Sprintf("attribute %s holds a value of type %T", name, elem))
 		}
+		values = append(values, value)
+	}
+	return values, nil
+}
 
-		permName, ok := recordElems[0].(string)
-		if !ok {
-			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm name")
-		}
-		permKindInt, ok := recordElems[1].(int64)
-		if !ok {
-			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm kind")
-		}
-		permKind := domain.PermissionKind(permKindInt)
-		permCond, ok := recordElems[2].(string)
-		if !ok {
-			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm cond")
+// neo4jAttributeValue converts attribute values that the driver would otherwise
+// store as plain numbers (durations) to their native neo4j types
+func neo4jAttributeValue(attr domain.Attribute) interface{} {
+	if duration, ok := attr.Value().(time.Duration); ok && attr.Kind() == domain.Duration {
+		return neo4j.DurationOf(0, 0, int64(duration/time.Second), int(duration%time.Second))
+	}
+	return attr.Value()
+}
+
+// getCreateInheritanceRelOutcome returns nil if the relationship was created, or an error
+// explaining why the write was a no-op
+func getCreateInheritanceRelOutcome(cypherResult interface{}) error {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok || len(records) == 0 {
+		return errors.New("invalid resp format")
+	}
+	relExists, ok := records[0].Values[0].(bool)
+	if !ok {
+		return errors.New("invalid record elem type - rel exists")
+	}
+	cycle, ok := records[0].Values[1].(bool)
+	if !ok {
+		return errors.New("invalid record elem type - cycle")
+	}
+	if relExists {
+		return domain.ErrInheritanceRelExists
+	}
+	if cycle {
+		return domain.ErrInheritanceRelCycle
+	}
+	return nil
+}
+
+// getDeleteOutcome returns an outcome that fails with notFound if nothing was deleted,
+// so that the transaction is rolled back before an event or audit record is written for it
+func getDeleteOutcome(notFound error) func(cypherResult interface{}) error {
+	return func(cypherResult interface{}) error {
+		records, ok := cypherResult.([]*neo4j.Record)
+		if !ok || len(records) == 0 {
+			return errors.New("invalid resp format")
 		}
-		subPriorityInt, ok := recordElems[3].(int64)
+		deleted, ok := records[0].Values[0].(int64)
 		if !ok {
-			log.Printf("%T\n", recordElems[3])
-			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm sub priority")
+			return errors.New("invalid record elem type - deleted")
 		}
-		subPriority := domain.PermissionPriority(subPriorityInt)
-		objPriorityInt, ok := recordElems[4].(int64)
-		if !ok {
-			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm obj priority")
+		if deleted == 0 {
+			return notFound
 		}
-		objPriority := domain.PermissionPriority(objPriorityInt)
+		return nil
+	}
+}
+
+func getHierarchy(cypherResult interface{}) (domain.PermissionHierarchy, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return domain.PermissionHierarchy{}, errors.New("invalid resp format")
+	}
 
-		// kreiraj dozvolu
-		cond, err := domain.NewCondition(permCond)
+	hierarchy := make(domain.PermissionHierarchy)
+	for _, record := range records {
+		err := addHierarchyPermission(hierarchy, record.Values)
 		if err != nil {
-			return domain.PermissionHierarchy{}, errors.New("invalid condition")
+			return domain.PermissionHierarchy{}, err
+		}
+	}
+	return hierarchy, nil
+}
+
+// getHierarchies maps records holding the index of a check followed by a permission as in getHierarchy
+func getHierarchies(cypherResult interface{}, checks int) ([]domain.PermissionHierarchy, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+
+	hierarchies := make([]domain.PermissionHierarchy, checks)
+	for i := range hierarchies {
+		hierarchies[i] = make(domain.PermissionHierarchy)
+	}
+	for _, record := range records {
+		i, ok := record.Values[0].(int64)
+		if !ok || i < 0 || int(i) >= checks {
+			return nil, errors.New("invalid record elem type - check index")
 		}
-		perm, err := domain.NewPermission(permName, permKind, *cond)
+		err := addHierarchyPermission(hierarchies[i], record.Values[1:])
 		if err != nil {
 			return nil, err
 		}
-		// proveri kom obj hierarchy elem pripada, ako ga nema kreiraj
-		_, ok = hierarchy[subPriority]
-		if !ok {
-			hierarchy[subPriority] = make(map[domain.PermissionPriority]domain.PermissionLevel)
-		}
-		objHierarchy := hierarchy[subPriority]
-		// proveri kom perm level-u (unutar obj hierarchy) elem pripada, ako ga nema kreiraj
-		_, ok = objHierarchy[objPriority]
-		if !ok {
-			objHierarchy[objPriority] = make([]domain.Permission, 0)
-		}
-		// perm level-u dodaj perm
-		objHierarchy[objPriority] = append(objHierarchy[objPriority], *perm)
-		// izmeni hierarchy, dodeli mu novi obj hierarchy
+	}
+	return hierarchies, nil
+}
+
+// addHierarchyPermission adds the permission held by the record values to the hierarchy
+// level given by its subject and object priorities
+func addHierarchyPermission(hierarchy domain.PermissionHierarchy, recordElems []interface{}) error {
+	permName, ok := recordElems[0].(string)
+	if !ok {
+		return errors.New("invalid record elem type - perm name")
+	}
+	permKindInt, ok := recordElems[1].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - perm kind")
+	}
+	permKind := domain.PermissionKind(permKindInt)
+	permCond, ok := recordElems[2].(string)
+	if !ok {
+		return errors.New("invalid record elem type - perm cond")
+	}
+	subPriorityInt, ok := recordElems[3].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - perm sub priority")
+	}
+	subPriority := domain.PermissionPriority(subPriorityInt)
+	objPriorityInt, ok := recordElems[4].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - perm obj priority")
+	}
+	objPriority := domain.PermissionPriority(objPriorityInt)
+	subScopeName, ok := recordElems[5].(string)
+	if !ok {
+		return errors.New("invalid record elem type - perm sub scope")
+	}
+	subScope, err := domain.NewResourceFromName(subScopeName)
+	if err != nil {
+		return err
+	}
+	objScopeName, ok := recordElems[6].(string)
+	if !ok {
+		return errors.New("invalid record elem type - perm obj scope")
+	}
+	objScope, err := domain.NewResourceFromName(objScopeName)
+	if err != nil {
+		return err
+	}
+
+	// kreiraj dozvolu
+	cond, err := domain.NewCondition(permCond)
+	if err != nil {
+		return errors.New("invalid condition")
+	}
+	perm, err := domain.NewPermission(permName, permKind, *cond)
+	if err != nil {
+		return err
+	}
+	perm.SetScope(domain.PermissionScope{
+		Subject: *subScope,
+		Object:  *objScope,
+	})
+	// proveri kom obj hierarchy elem pripada, ako ga nema kreiraj
+	objHierarchy, ok := hierarchy[subPriority]
+	if !ok {
+		objHierarchy = make(domain.PermissionObjHierarchy)
 		hierarchy[subPriority] = objHierarchy
 	}
-	return hierarchy, nil
+	// perm level-u dodaj perm
+	objHierarchy[objPriority] = append(objHierarchy[objPriority], *perm)
+	return nil
 }
 
 func getPolicies(cypherResult interface{}) ([]domain.Policy, error) {
@@ -139,3 +287,416 @@ func getPolicies(cypherResult interface{}) ([]domain.Policy, error) {
 	}
 	return policies, nil
 }
+
+// getResourcesByName maps records whose first element is a resource name
+func getResourcesByName(cypherResult interface{}) ([]domain.Resource, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+
+	resources := make([]domain.Resource, 0, len(records))
+	for _, record := range records {
+		name, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - resource name")
+		}
+		resource, err := domain.NewResourceFromName(name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, *resource)
+	}
+	return resources, nil
+}
+
+// getRelatedNames maps records holding the name of a resource whose relatives
+// were requested, followed by a relative's name, if there are any
+func getRelatedNames(cypherResult interface{}) ([]string, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	if len(records) == 0 {
+		return nil, domain.ErrResourceNotFound
+	}
+
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		if record.Values[1] == nil {
+			continue
+		}
+		name, ok := record.Values[1].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - resource name")
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// getStoredPolicies maps records holding a permission's name, kind and condition,
+// followed by its subject and object scope names, and the permission sequence number
+func getStoredPolicies(cypherResult interface{}) ([]domain.Permission, int64, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, 0, errors.New("invalid resp format")
+	}
+
+	policies := make([]domain.Permission, 0, len(records))
+	var lastSequence int64
+	for _, record := range records {
+		policy, err := getPolicy(record.Values)
+		if err != nil {
+			return nil, 0, err
+		}
+		lastSequence, ok = record.Values[5].(int64)
+		if !ok {
+			return nil, 0, errors.New("invalid record elem type - perm sequence")
+		}
+		policies = append(policies, *policy)
+	}
+	return policies, lastSequence, nil
+}
+
+// getInheritedPolicies maps records holding a policy as in getStoredPolicies,
+// followed by the resource's role in the policy and the distance to the policy's scope
+func getInheritedPolicies(cypherResult interface{}) ([]domain.InheritedPolicy, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+
+	policies := make([]domain.InheritedPolicy, 0, len(records))
+	for _, record := range records {
+		policy, err := getPolicy(record.Values)
+		if err != nil {
+			return nil, err
+		}
+		asSubject, ok := record.Values[5].(bool)
+		if !ok {
+			return nil, errors.New("invalid record elem type - as subject")
+		}
+		distance, ok := record.Values[6].(int64)
+		if !ok {
+			return nil, errors.New("invalid record elem type - distance")
+		}
+		policies = append(policies, domain.InheritedPolicy{
+			Policy:    *policy,
+			AsSubject: asSubject,
+			Distance:  int(distance),
+		})
+	}
+	return policies, nil
+}
+
+func getPolicy(recordElems []interface{}) (*domain.Permission, error) {
+	permName, ok := recordElems[0].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - perm name")
+	}
+	permKind, ok := recordElems[1].(int64)
+	if !ok {
+		return nil, errors.New("invalid record elem type - perm kind")
+	}
+	permCond, ok := recordElems[2].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - perm cond")
+	}
+	subScopeName, ok := recordElems[3].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - perm sub scope")
+	}
+	objScopeName, ok := recordElems[4].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - perm obj scope")
+	}
+	subScope, err := domain.NewResourceFromName(subScopeName)
+	if err != nil {
+		return nil, err
+	}
+	objScope, err := domain.NewResourceFromName(objScopeName)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := domain.NewCondition(permCond)
+	if err != nil {
+		return nil, errors.New("invalid condition")
+	}
+	perm, err := domain.NewPermission(permName, domain.PermissionKind(permKind), *cond)
+	if err != nil {
+		return nil, err
+	}
+	perm.SetScope(domain.PermissionScope{
+		Subject: *subScope,
+		Object:  *objScope,
+	})
+	return perm, nil
+}
+
+// getEvents maps records holding the properties of outbox event nodes
+func getEvents(cypherResult interface{}) ([]domain.Event, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+
+	events := make([]domain.Event, 0, len(records))
+	for _, record := range records {
+		props, ok := record.Values[0].(map[string]interface{})
+		if !ok {
+			return nil, errors.New("invalid record elem type - event")
+		}
+		event, err := getEvent(props)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	return events, nil
+}
+
+func getEvent(props map[string]interface{}) (*domain.Event, error) {
+	id, _ := props["id"].(string)
+	revision, _ := props["revision"].(int64)
+	timestamp, _ := props["timestamp"].(time.Time)
+	entity, _ := props["entity"].(string)
+	action, _ := props["action"].(string)
+	event := &domain.Event{
+		Id:        id,
+		Revision:  revision,
+		Timestamp: timestamp,
+		Entity:    domain.EventEntity(entity),
+		Action:    domain.EventAction(action),
+	}
+	var err error
+	switch event.Entity {
+	case domain.EventEntityResource:
+		event.Resource, err = getPropsResource(props, "resource")
+	case domain.EventEntityAttribute:
+		event.Resource, err = getPropsResource(props, "resource")
+		if err != nil {
+			return nil, err
+		}
+		err = getEventAttribute(props, event)
+	case domain.EventEntityInheritanceRel:
+		event.From, err = getPropsResource(props, "fromName")
+		if err != nil {
+			return nil, err
+		}
+		event.To, err = getPropsResource(props, "toName")
+	case domain.EventEntityPolicy:
+		var policy *domain.Permission
+		policy, err = getPropsPolicy(props)
+		if err == nil {
+			event.Policy = *policy
+		}
+	default:
+		err = errors.New("invalid event entity")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func getEventAttribute(props map[string]interface{}, event *domain.Event) error {
+	name, ok := props["attrName"].(string)
+	if !ok {
+		return errors.New("invalid event prop type - attr name")
+	}
+	attrId, err := domain.NewAttributeId(name)
+	if err != nil {
+		return err
+	}
+	event.AttributeId = *attrId
+	kind, ok := props["attrKind"].(int64)
+	if !ok {
+		// the attribute has been deleted
+		return nil
+	}
+	value, err := attributeValue(name, domain.AttributeKind(kind), props["attrValue"])
+	if err != nil {
+		return err
+	}
+	attribute, err := domain.NewAttribute(*attrId, domain.AttributeKind(kind), value)
+	if err != nil {
+		return err
+	}
+	event.Attribute = attribute
+	return nil
+}
+
+func getPropsResource(props map[string]interface{}, key string) (domain.Resource, error) {
+	name, ok := props[key].(string)
+	if !ok {
+		return domain.Resource{}, fmt.Errorf("invalid prop type - %s", key)
+	}
+	resource, err := domain.NewResourceFromName(name)
+	if err != nil {
+		return domain.Resource{}, err
+	}
+	return *resource, nil
+}
+
+// getPropsPolicy maps the policy properties stored in outbox events and audit snapshots
+func getPropsPolicy(props map[string]interface{}) (*domain.Permission, error) {
+	subScope, err := getPropsResource(props, "subScope")
+	if err != nil {
+		return nil, err
+	}
+	objScope, err := getPropsResource(props, "objScope")
+	if err != nil {
+		return nil, err
+	}
+	permName, ok := props["permName"].(string)
+	if !ok {
+		return nil, errors.New("invalid prop type - perm name")
+	}
+	permKind, ok := props["permKind"].(int64)
+	if !ok {
+		return nil, errors.New("invalid prop type - perm kind")
+	}
+	permCond, _ := props["permCond"].(string)
+	cond, err := domain.NewCondition(permCond)
+	if err != nil {
+		return nil, errors.New("invalid condition")
+	}
+	perm, err := domain.NewPermission(permName, domain.PermissionKind(permKind), *cond)
+	if err != nil {
+		return nil, err
+	}
+	perm.SetScope(domain.PermissionScope{
+		Subject: subScope,
+		Object:  objScope,
+	})
+	return perm, nil
+}
+
+func getRevision(cypherResult interface{}) (int64, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok || len(records) == 0 {
+		return 0, errors.New("invalid resp format")
+	}
+	revision, ok := records[0].Values[0].(int64)
+	if !ok {
+		return 0, errors.New("invalid record elem type - revision")
+	}
+	return revision, nil
+}
+
+// getAuditRecords maps records holding the record id and properties, followed by
+// the properties and attributes of the snapshots before and after the operation
+func getAuditRecords(cypherResult interface{}) ([]domain.AuditRecord, int64, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, 0, errors.New("invalid resp format")
+	}
+
+	auditRecords := make([]domain.AuditRecord, 0, len(records))
+	var lastSequence int64
+	for _, record := range records {
+		lastSequence, ok = record.Values[0].(int64)
+		if !ok {
+			return nil, 0, errors.New("invalid record elem type - audit record sequence")
+		}
+		props, ok := record.Values[1].(map[string]interface{})
+		if !ok {
+			return nil, 0, errors.New("invalid record elem type - audit record")
+		}
+		id, _ := props["id"].(string)
+		actor, _ := props["actor"].(string)
+		actorVerified, _ := props["actorVerified"].(bool)
+		timestamp, _ := props["timestamp"].(time.Time)
+		entity, _ := props["entity"].(string)
+		action, _ := props["action"].(string)
+		auditRecord := domain.AuditRecord{
+			Id:            id,
+			Actor:         actor,
+			ActorVerified: actorVerified,
+			Timestamp:     timestamp,
+			Entity:        domain.EventEntity(entity),
+			Action:        domain.EventAction(action),
+		}
+		var err error
+		auditRecord.Before, err = getAuditSnapshot(auditRecord.Entity, record.Values[2], record.Values[3])
+		if err != nil {
+			return nil, 0, err
+		}
+		auditRecord.After, err = getAuditSnapshot(auditRecord.Entity, record.Values[4], record.Values[5])
+		if err != nil {
+			return nil, 0, err
+		}
+		auditRecords = append(auditRecords, auditRecord)
+	}
+	return auditRecords, lastSequence, nil
+}
+
+func getAuditSnapshot(entity domain.EventEntity, snapshotProps, attrs interface{}) (*domain.AuditSnapshot, error) {
+	props, ok := snapshotProps.(map[string]interface{})
+	if !ok {
+		// the entity didn't exist
+		return nil, nil
+	}
+	snapshot := &domain.AuditSnapshot{}
+	var err error
+	switch entity {
+	case domain.EventEntityResource, domain.EventEntityAttribute:
+		snapshot.Resource, err = getPropsResource(props, "resource")
+		if err != nil {
+			return nil, err
+		}
+		attrList, _ := attrs.([]interface{})
+		snapshot.Attributes = make([]domain.Attribute, 0, len(attrList))
+		for _, attr := range attrList {
+			attrProps, ok := attr.(map[string]interface{})
+			if !ok {
+				return nil, errors.New("invalid record elem type - audit attribute")
+			}
+			attribute, err := getPropsAttribute(attrProps)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Attributes = append(snapshot.Attributes, *attribute)
+		}
+	case domain.EventEntityInheritanceRel:
+		snapshot.From, err = getPropsResource(props, "fromName")
+		if err != nil {
+			return nil, err
+		}
+		snapshot.To, err = getPropsResource(props, "toName")
+	case domain.EventEntityPolicy:
+		var policy *domain.Permission
+		policy, err = getPropsPolicy(props)
+		if err == nil {
+			snapshot.Policy = *policy
+		}
+	default:
+		err = errors.New("invalid audit record entity")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// getPropsAttribute maps the properties of an attribute node
+func getPropsAttribute(props map[string]interface{}) (*domain.Attribute, error) {
+	name, ok := props["name"].(string)
+	if !ok {
+		return nil, errors.New("invalid prop type - attr name")
+	}
+	kind, ok := props["kind"].(int64)
+	if !ok {
+		return nil, errors.New("invalid prop type - attr kind")
+	}
+	attrId, err := domain.NewAttributeId(name)
+	if err != nil {
+		return nil, err
+	}
+	value, err := attributeValue(name, domain.AttributeKind(kind), props["value"])
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewAttribute(*attrId, domain.AttributeKind(kind), value)
+}
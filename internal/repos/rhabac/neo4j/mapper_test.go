@@ -0,0 +1,62 @@
+package neo4j
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+type attributeValueTestCase struct {
+	kind        domain.AttributeKind
+	value       interface{}
+	expected    interface{}
+	err         bool
+	description string
+}
+
+var attributeValueTestCases = []attributeValueTestCase{
+	{
+		kind:        domain.StringList,
+		value:       []interface{}{"a", "b"},
+		expected:    []string{"a", "b"},
+		description: "string list",
+	},
+	{
+		kind:        domain.Int64List,
+		value:       []interface{}{int64(1), int64(2)},
+		expected:    []int64{1, 2},
+		description: "int64 list",
+	},
+	{
+		kind:        domain.StringList,
+		value:       []interface{}{"a", int64(1)},
+		err:         true,
+		description: "string list with an int64 element",
+	},
+	{
+		kind:        domain.Int64List,
+		value:       []interface{}{int64(1), "b"},
+		err:         true,
+		description: "int64 list with a string element",
+	},
+}
+
+func TestAttributeValue(t *testing.T) {
+	for _, testCase := range attributeValueTestCases {
+		value, err := attributeValue("attr", testCase.kind, testCase.value)
+		if testCase.err {
+			if kind := domain.KindOf(err); kind != domain.ErrKindInternal {
+				t.Errorf("%s: expected an internal error, got %v", testCase.description, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", testCase.description, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, testCase.expected) {
+			t.Errorf("%s: expected %v, got %v", testCase.description, testCase.expected, value)
+		}
+	}
+}
@@ -1,7 +1,12 @@
 package neo4j
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/c12s/oort/internal/domain"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -19,88 +24,366 @@ func NewRHABACRepo(manager *TransactionManager, factory CypherFactory) domain.RH
 	}
 }
 
-func (store RHABACRepo) CreateResource(req domain.CreateResourceReq) domain.AdministrationResp {
-	cypher, params := store.factory.createResource(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
-}
-
-func (store RHABACRepo) DeleteResource(req domain.DeleteResourceReq) domain.AdministrationResp {
-	cypher, params := store.factory.deleteResource(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
-}
-
 func (store RHABACRepo) GetResource(req domain.GetResourceReq) domain.GetResourceResp {
 	cypher, params := store.factory.getResource(req)
 	records, err := store.manager.ReadTransaction(cypher, params)
 	if err != nil {
-		return domain.GetResourceResp{Resource: nil, Error: err}
+		return domain.GetResourceResp{Resource: nil, Error: classifyError(err)}
 	}
 
 	recordList, ok := records.([]*neo4j.Record)
 	if !ok {
-		return domain.GetResourceResp{Error: errors.New("invalid resp format")}
+		return domain.GetResourceResp{Error: classifyError(errors.New("invalid resp format"))}
 	}
 	if len(recordList) == 0 {
-		return domain.GetResourceResp{Error: errors.New("resource not found")}
+		return domain.GetResourceResp{Error: domain.ErrResourceNotFound}
 	}
-	return domain.GetResourceResp{Resource: getResource(records), Error: nil}
+	resource, err := getResource(records)
+	return domain.GetResourceResp{Resource: resource, Error: classifyError(err)}
 }
 
-func (store RHABACRepo) PutAttribute(req domain.PutAttributeReq) domain.AdministrationResp {
-	cypher, params := store.factory.putAttribute(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
+func (store RHABACRepo) GetResourcesWithAttributes(req domain.GetResourcesWithAttributesReq) domain.GetResourcesWithAttributesResp {
+	if len(req.Resources) == 0 {
+		return domain.GetResourcesWithAttributesResp{Resources: []domain.Resource{}, Error: nil}
+	}
+	cypher, params := store.factory.getResourcesWithAttributes(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetResourcesWithAttributesResp{Resources: nil, Error: classifyError(err)}
+	}
+	resources, err := getResourcesWithAttributes(records)
+	return domain.GetResourcesWithAttributesResp{Resources: resources, Error: classifyError(err)}
 }
 
-func (store RHABACRepo) DeleteAttribute(req domain.DeleteAttributeReq) domain.AdministrationResp {
-	cypher, params := store.factory.deleteAttribute(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
+func (store RHABACRepo) GetResources(req domain.GetResourcesReq) domain.GetResourcesResp {
+	cypher, params := store.factory.getResources(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetResourcesResp{Resources: nil, Error: classifyError(err)}
+	}
+	resources, err := getResourcesByName(records)
+	return domain.GetResourcesResp{Resources: resources, Error: classifyError(err)}
 }
 
-func (store RHABACRepo) CreateInheritanceRel(req domain.CreateInheritanceRelReq) domain.AdministrationResp {
-	cypher, params := store.factory.createInheritanceRel(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
+// GetChildren traverses inheritance relationships breadth first, so every child is reached once,
+// at the length of its shortest path. The page token is the depth and name of the last returned child.
+func (store RHABACRepo) GetChildren(req domain.ListChildrenReq) domain.ListRelatedResourcesResp {
+	return store.getRelatedResources(req.Resource, req.MaxDepth, req.PageSize, req.PageToken, store.factory.getChildren)
 }
 
-func (store RHABACRepo) DeleteInheritanceRel(req domain.DeleteInheritanceRelReq) domain.AdministrationResp {
-	cypher, params := store.factory.deleteInheritanceRel(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
+// GetAncestors pages through ancestors the same way GetChildren pages through children
+func (store RHABACRepo) GetAncestors(req domain.ListAncestorsReq) domain.ListRelatedResourcesResp {
+	return store.getRelatedResources(req.Resource, req.MaxDepth, req.PageSize, req.PageToken, store.factory.getAncestors)
 }
 
-func (store RHABACRepo) CreatePolicy(req domain.CreatePolicyReq) domain.AdministrationResp {
-	cypher, params := store.factory.createPolicy(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
+// getRelatedResources expands one level per statement, all in the same transaction, until the depth limit
+// is reached or the page is filled. Relatives are ordered by depth and then by name.
+func (store RHABACRepo) getRelatedResources(resource domain.Resource, maxDepth, pageSize int, pageToken string,
+	level func(names []string) (string, map[string]interface{})) domain.ListRelatedResourcesResp {
+	afterDepth, afterName, err := parseRelatedPageToken(pageToken)
+	if err != nil {
+		return domain.ListRelatedResourcesResp{Error: err}
+	}
+	result, err := store.manager.ReadTransactionWork(func(read ReadFunc) (interface{}, error) {
+		visited := map[string]bool{resource.Name(): true}
+		frontier := []string{resource.Name()}
+		page := make([]domain.RelatedResource, 0, pageSize)
+		for depth := 1; len(frontier) > 0 && len(page) < pageSize && (maxDepth <= 0 || depth <= maxDepth); depth++ {
+			cypher, params := level(frontier)
+			records, err := read(cypher, params)
+			if err != nil {
+				return nil, err
+			}
+			names, err := getRelatedNames(records)
+			if err != nil {
+				return nil, err
+			}
+			next := make([]string, 0, len(names))
+			for _, name := range names {
+				if !visited[name] {
+					visited[name] = true
+					next = append(next, name)
+				}
+			}
+			sort.Strings(next)
+			for _, name := range next {
+				if len(page) == pageSize {
+					break
+				}
+				if depth < afterDepth || depth == afterDepth && name <= afterName {
+					continue
+				}
+				related, err := domain.NewResourceFromName(name)
+				if err != nil {
+					return nil, err
+				}
+				page = append(page, domain.RelatedResource{Resource: *related, Depth: depth})
+			}
+			frontier = next
+		}
+		return page, nil
+	})
+	if err != nil {
+		return domain.ListRelatedResourcesResp{Error: classifyError(err)}
+	}
+	resources := result.([]domain.RelatedResource)
+	nextPageToken := ""
+	if len(resources) == pageSize {
+		last := resources[pageSize-1]
+		nextPageToken = strconv.Itoa(last.Depth) + ":" + last.Resource.Name()
+	}
+	return domain.ListRelatedResourcesResp{Resources: resources, NextPageToken: nextPageToken}
 }
 
-func (store RHABACRepo) DeletePolicy(req domain.DeletePolicyReq) domain.AdministrationResp {
-	cypher, params := store.factory.deletePolicy(req)
-	err := store.manager.WriteTransaction(cypher, params)
-	return domain.AdministrationResp{Error: err}
+func parseRelatedPageToken(pageToken string) (int, string, error) {
+	if pageToken == "" {
+		return 0, "", nil
+	}
+	depth, name, found := strings.Cut(pageToken, ":")
+	if !found {
+		return 0, "", domain.NewError(domain.ErrKindInvalidArgument, "invalid page token")
+	}
+	afterDepth, err := strconv.Atoi(depth)
+	if err != nil {
+		return 0, "", domain.NewError(domain.ErrKindInvalidArgument, "invalid page token")
+	}
+	return afterDepth, name, nil
+}
+
+// ApplyBatch applies all operations in a single transaction. Each operation is surrounded by statements
+// that record it in the audit log with snapshots of the affected entity, and followed by one that writes
+// its change event to the outbox.
+func (store RHABACRepo) ApplyBatch(req domain.ApplyBatchReq) domain.ApplyBatchResp {
+	cyphers := make([]string, 0, statementsPerOp*len(req.Operations))
+	params := make([]map[string]interface{}, 0, statementsPerOp*len(req.Operations))
+	outcomes := make([]func(cypherResult interface{}) error, 0, statementsPerOp*len(req.Operations))
+	for _, op := range req.Operations {
+		cypher, param, outcome, err := store.batchStatement(op)
+		if err != nil {
+			return domain.ApplyBatchResp{Error: err}
+		}
+		auditId, err := newAuditId()
+		if err != nil {
+			return domain.ApplyBatchResp{Error: classifyError(err)}
+		}
+		event := op.ChangeEvent()
+		auditCypher, auditParams := store.factory.createAuditRecord(auditId, req.Actor, req.ActorVerified, event)
+		completeCypher, completeParams := store.factory.completeAuditRecord(auditId, event)
+		eventCypher, eventParams := store.factory.createOutboxEvent(event)
+		cyphers = append(cyphers, auditCypher, cypher, completeCypher, eventCypher)
+		params = append(params, auditParams, param, completeParams, eventParams)
+		outcomes = append(outcomes, nil, outcome, nil, nil)
+	}
+
+	failed, err := store.manager.WriteTransactionsWithResults(cyphers, params, func(i int, records []*neo4j.Record) error {
+		if outcomes[i] == nil {
+			return nil
+		}
+		return outcomes[i](records)
+	})
+	results := make([]domain.AdministrationResp, len(req.Operations))
+	if err == nil {
+		return domain.ApplyBatchResp{Results: results, Error: nil}
+	}
+	for i := range results {
+		results[i].Error = domain.ErrBatchAborted
+	}
+	if failed < 0 {
+		return domain.ApplyBatchResp{Results: results, Error: classifyError(err)}
+	}
+	results[failed/statementsPerOp].Error = classifyError(err)
+	return domain.ApplyBatchResp{Results: results, Error: nil}
+}
+
+// audit record, operation, audit snapshot after the operation, outbox event
+const statementsPerOp = 4
+
+func newAuditId() (string, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// batchStatement returns the cypher and params of an operation, along with
+// the function that checks its outcome if the cypher alone can't fail the operation
+func (store RHABACRepo) batchStatement(op domain.AdministrationOp) (string, map[string]interface{}, func(cypherResult interface{}) error, error) {
+	switch req := op.(type) {
+	case domain.CreateResourceReq:
+		cypher, params := store.factory.createResource(req)
+		return cypher, params, nil, nil
+	case domain.DeleteResourceReq:
+		cypher, params := store.factory.deleteResource(req)
+		return cypher, params, getDeleteOutcome(domain.ErrResourceNotFound), nil
+	case domain.PutAttributeReq:
+		cypher, params := store.factory.putAttribute(req)
+		return cypher, params, nil, nil
+	case domain.DeleteAttributeReq:
+		cypher, params := store.factory.deleteAttribute(req)
+		return cypher, params, getDeleteOutcome(domain.ErrAttributeNotFound), nil
+	case domain.CreateInheritanceRelReq:
+		cypher, params := store.factory.createInheritanceRel(req)
+		return cypher, params, getCreateInheritanceRelOutcome, nil
+	case domain.DeleteInheritanceRelReq:
+		cypher, params := store.factory.deleteInheritanceRel(req)
+		return cypher, params, getDeleteOutcome(domain.ErrInheritanceRelNotFound), nil
+	case domain.CreatePolicyReq:
+		cypher, params := store.factory.createPolicy(req)
+		return cypher, params, nil, nil
+	case domain.DeletePolicyReq:
+		cypher, params := store.factory.deletePolicy(req)
+		return cypher, params, getDeleteOutcome(domain.ErrPolicyNotFound), nil
+	default:
+		return "", nil, nil, domain.NewError(domain.ErrKindInvalidArgument, "unknown operation")
+	}
 }
 
 func (store RHABACRepo) GetPermissionHierarchy(req domain.GetPermissionHierarchyReq) domain.GetPermissionHierarchyResp {
 	cypher, params := store.factory.getEffectivePermissionsWithPriority(req)
 	records, err := store.manager.ReadTransaction(cypher, params)
 	if err != nil {
-		return domain.GetPermissionHierarchyResp{Hierarchy: nil, Error: err}
+		return domain.GetPermissionHierarchyResp{Hierarchy: nil, Error: classifyError(err)}
 	}
 
 	hierarchy, err := getHierarchy(records)
-	return domain.GetPermissionHierarchyResp{Hierarchy: hierarchy, Error: err}
+	return domain.GetPermissionHierarchyResp{Hierarchy: hierarchy, Error: classifyError(err)}
+}
+
+func (store RHABACRepo) GetPermissionHierarchies(req domain.GetPermissionHierarchiesReq) domain.GetPermissionHierarchiesResp {
+	if len(req.Checks) == 0 {
+		return domain.GetPermissionHierarchiesResp{Hierarchies: []domain.PermissionHierarchy{}, Error: nil}
+	}
+	cypher, params := store.factory.getEffectivePermissionsWithPriorityBatch(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetPermissionHierarchiesResp{Hierarchies: nil, Error: classifyError(err)}
+	}
+	hierarchies, err := getHierarchies(records, len(req.Checks))
+	return domain.GetPermissionHierarchiesResp{Hierarchies: hierarchies, Error: classifyError(err)}
 }
 
 func (store RHABACRepo) GetApplicablePolicies(req domain.GetApplicablePoliciesReq) domain.GetApplicablePoliciesResp {
 	cypher, params := store.factory.getApplicablePolicies(req)
 	records, err := store.manager.ReadTransaction(cypher, params)
 	if err != nil {
-		return domain.GetApplicablePoliciesResp{Policies: nil, Error: err}
+		return domain.GetApplicablePoliciesResp{Policies: nil, Error: classifyError(err)}
 	}
 	policies, err := getPolicies(records)
-	return domain.GetApplicablePoliciesResp{Policies: policies, Error: err}
+	return domain.GetApplicablePoliciesResp{Policies: policies, Error: classifyError(err)}
+}
+
+// GetPolicies pages through policies in creation order, the page token being the last returned sequence number
+func (store RHABACRepo) GetPolicies(req domain.ListPoliciesReq) domain.ListPoliciesResp {
+	afterSequence := int64(0)
+	if req.PageToken != "" {
+		sequence, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil {
+			return domain.ListPoliciesResp{Error: domain.NewError(domain.ErrKindInvalidArgument, "invalid page token")}
+		}
+		afterSequence = sequence
+	}
+	cypher, params := store.factory.getPolicies(req, afterSequence)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.ListPoliciesResp{Policies: nil, Error: classifyError(err)}
+	}
+	policies, lastSequence, err := getStoredPolicies(records)
+	if err != nil {
+		return domain.ListPoliciesResp{Policies: nil, Error: classifyError(err)}
+	}
+	nextPageToken := ""
+	if len(policies) == req.PageSize {
+		nextPageToken = strconv.FormatInt(lastSequence, 10)
+	}
+	return domain.ListPoliciesResp{Policies: policies, NextPageToken: nextPageToken, Error: nil}
+}
+
+func (store RHABACRepo) GetInheritedPolicies(req domain.GetPoliciesForResourceReq) domain.GetPoliciesForResourceResp {
+	cypher, params := store.factory.getInheritedPolicies(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetPoliciesForResourceResp{Policies: nil, Error: classifyError(err)}
+	}
+	policies, err := getInheritedPolicies(records)
+	return domain.GetPoliciesForResourceResp{Policies: policies, Error: classifyError(err)}
+}
+
+func (store RHABACRepo) GetSubjectCandidates(req domain.GetSubjectCandidatesReq) domain.GetSubjectCandidatesResp {
+	cypher, params := store.factory.getSubjectCandidates(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetSubjectCandidatesResp{Subjects: nil, Error: classifyError(err)}
+	}
+	subjects, err := getResourcesByName(records)
+	return domain.GetSubjectCandidatesResp{Subjects: subjects, Error: classifyError(err)}
+}
+
+func (store RHABACRepo) GetObjectCandidates(req domain.GetObjectCandidatesReq) domain.GetObjectCandidatesResp {
+	cypher, params := store.factory.getObjectCandidates(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetObjectCandidatesResp{Objects: nil, Error: classifyError(err)}
+	}
+	objects, err := getResourcesByName(records)
+	return domain.GetObjectCandidatesResp{Objects: objects, Error: classifyError(err)}
+}
+
+func (store RHABACRepo) GetEvents(req domain.GetEventsReq) domain.GetEventsResp {
+	cypher, params := store.factory.getEvents(req)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetEventsResp{Events: nil, Error: classifyError(err)}
+	}
+	events, err := getEvents(records)
+	return domain.GetEventsResp{Events: events, Error: classifyError(err)}
+}
+
+func (store RHABACRepo) GetRevision() domain.GetRevisionResp {
+	cypher, params := store.factory.getRevision()
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.GetRevisionResp{Revision: 0, Error: classifyError(err)}
+	}
+	revision, err := getRevision(records)
+	return domain.GetRevisionResp{Revision: revision, Error: classifyError(err)}
+}
+
+func (store RHABACRepo) MarkEventsDelivered(req domain.MarkEventsDeliveredReq) domain.AdministrationResp {
+	cypher, params := store.factory.markEventsDelivered(req)
+	err := store.manager.WriteTransaction(cypher, params)
+	return domain.AdministrationResp{Error: classifyError(err)}
+}
+
+func (store RHABACRepo) PruneEvents(req domain.PruneEventsReq) domain.AdministrationResp {
+	cypher, params := store.factory.pruneEvents(req)
+	err := store.manager.WriteTransaction(cypher, params)
+	return domain.AdministrationResp{Error: classifyError(err)}
+}
+
+// GetAuditRecords pages through records by sequence number, the page token being the last returned one
+func (store RHABACRepo) GetAuditRecords(req domain.ListAuditRecordsReq) domain.ListAuditRecordsResp {
+	afterSequence := int64(0)
+	if req.PageToken != "" {
+		sequence, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil {
+			return domain.ListAuditRecordsResp{Error: domain.NewError(domain.ErrKindInvalidArgument, "invalid page token")}
+		}
+		afterSequence = sequence
+	}
+	cypher, params := store.factory.getAuditRecords(req, afterSequence)
+	records, err := store.manager.ReadTransaction(cypher, params)
+	if err != nil {
+		return domain.ListAuditRecordsResp{Error: classifyError(err)}
+	}
+	auditRecords, lastSequence, err := getAuditRecords(records)
+	if err != nil {
+		return domain.ListAuditRecordsResp{Error: classifyError(err)}
+	}
+	nextPageToken := ""
+	if len(auditRecords) == req.PageSize {
+		nextPageToken = strconv.FormatInt(lastSequence, 10)
+	}
+	return domain.ListAuditRecordsResp{Records: auditRecords, NextPageToken: nextPageToken, Error: nil}
 }
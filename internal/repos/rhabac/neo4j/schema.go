@@ -0,0 +1,33 @@
+package neo4j
+
+// indexes and constraints the repo's queries rely on, along with properties that existing nodes
+// are missing, the statements are idempotent so they are run on every start
+var ncSchemaCyphers = []string{
+	`CREATE CONSTRAINT revision_name IF NOT EXISTS FOR (r:Revision) REQUIRE r.name IS UNIQUE`,
+	`CREATE CONSTRAINT outbox_event_id IF NOT EXISTS FOR (e:OutboxEvent) REQUIRE e.id IS UNIQUE`,
+	`CREATE INDEX outbox_event_revision IF NOT EXISTS FOR (e:OutboxEvent) ON (e.revision)`,
+	`CREATE INDEX outbox_event_delivered IF NOT EXISTS FOR (e:OutboxEvent) ON (e.delivered)`,
+	`CREATE INDEX outbox_event_delivered_at IF NOT EXISTS FOR (e:OutboxEvent) ON (e.deliveredAt)`,
+	`CREATE CONSTRAINT audit_record_id IF NOT EXISTS FOR (rec:AuditRecord) REQUIRE rec.id IS UNIQUE`,
+	`CREATE INDEX audit_record_sequence IF NOT EXISTS FOR (rec:AuditRecord) ON (rec.sequence)`,
+	`CREATE INDEX permission_sequence IF NOT EXISTS FOR (p:Permission) ON (p.sequence)`,
+	// permissions created before they were numbered are numbered in the order of their node ids
+	`MATCH (p:Permission) WHERE p.sequence IS NULL
+WITH p ORDER BY id(p)
+WITH collect(p) AS permissions
+MERGE (seq:Revision{name: "policies"})
+WITH seq, permissions, coalesce(seq.value, 0) AS last
+FOREACH (i IN range(0, size(permissions) - 1) | SET (permissions[i]).sequence = last + i + 1)
+SET seq.value = last + size(permissions)`,
+}
+
+// InitSchema runs the schema statements, each in its own transaction
+// since schema changes can't be mixed with other writes
+func InitSchema(manager *TransactionManager) error {
+	for _, cypher := range ncSchemaCyphers {
+		if err := manager.WriteTransaction(cypher, map[string]interface{}{}); err != nil {
+			return classifyError(err)
+		}
+	}
+	return nil
+}
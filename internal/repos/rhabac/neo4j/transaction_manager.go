@@ -10,8 +10,8 @@ type TransactionManager struct {
 	dbName string
 }
 
-func NewTransactionManager(uri, dbName string) (*TransactionManager, error) {
-	driver, err := neo4j.NewDriver(uri, neo4j.NoAuth())
+func NewTransactionManager(uri, dbName string, auth neo4j.AuthToken, configurers ...func(*neo4j.Config)) (*TransactionManager, error) {
+	driver, err := neo4j.NewDriver(uri, auth, configurers...)
 	if err != nil {
 		return nil, err
 	}
@@ -37,26 +37,58 @@ func (manager *TransactionManager) WriteTransaction(cypher string, params map[st
 	return err
 }
 
+func (manager *TransactionManager) WriteTransactionWithResult(cypher string, params map[string]interface{}) (interface{}, error) {
+	return manager.writeTransaction(func(transaction neo4j.Transaction) (interface{}, error) {
+		result, err := transaction.Run(cypher, params)
+		if err != nil {
+			return nil, err
+		}
+		if result.Err() != nil {
+			return nil, result.Err()
+		}
+		return result.Collect()
+	})
+}
+
 func (manager *TransactionManager) WriteTransactions(cyphers []string, params []map[string]interface{}) error {
+	_, err := manager.WriteTransactionsWithResults(cyphers, params, nil)
+	return err
+}
+
+// WriteTransactionsWithResults runs the cyphers in order in a single transaction, passing the records
+// of each one to check. If a cypher or check fails, nothing is committed and the index of the failed
+// cypher is returned along with the error. The index is -1 if the transaction failed as a whole.
+func (manager *TransactionManager) WriteTransactionsWithResults(cyphers []string, params []map[string]interface{},
+	check func(i int, records []*neo4j.Record) error) (int, error) {
+	failed := -1
 	_, err := manager.writeTransaction(func(transaction neo4j.Transaction) (interface{}, error) {
-		var txErr error = nil
+		// the driver retries the whole function on transient errors
+		failed = -1
 		for i := range cyphers {
-			cypher := cyphers[i]
-			param := params[i]
-			result, err := transaction.Run(cypher, param)
-			if err != nil || result.Err() != nil {
-				_ = transaction.Rollback()
-				if err != nil {
-					txErr = err
-				} else {
-					txErr = result.Err()
-				}
-				break
+			result, err := transaction.Run(cyphers[i], params[i])
+			if err != nil {
+				failed = i
+				return nil, err
+			}
+			records, err := result.Collect()
+			if err != nil {
+				failed = i
+				return nil, err
+			}
+			if check == nil {
+				continue
+			}
+			if err := check(i, records); err != nil {
+				failed = i
+				return nil, err
 			}
 		}
-		return txErr, nil
+		return nil, nil
 	})
-	return err
+	if err != nil {
+		return failed, err
+	}
+	return -1, nil
 }
 
 func (manager *TransactionManager) ReadTransaction(cypher string, params map[string]interface{}) (interface{}, error) {
@@ -72,6 +104,26 @@ func (manager *TransactionManager) ReadTransaction(cypher string, params map[str
 	})
 }
 
+// ReadFunc runs a statement in a transaction started by ReadTransactionWork
+type ReadFunc func(cypher string, params map[string]interface{}) (interface{}, error)
+
+// ReadTransactionWork runs work that issues several dependent reads in a single transaction,
+// work may be retried and must not keep state between calls
+func (manager *TransactionManager) ReadTransactionWork(work func(read ReadFunc) (interface{}, error)) (interface{}, error) {
+	return manager.readTransaction(func(transaction neo4j.Transaction) (interface{}, error) {
+		return work(func(cypher string, params map[string]interface{}) (interface{}, error) {
+			result, err := transaction.Run(cypher, params)
+			if err != nil {
+				return nil, err
+			}
+			if result.Err() != nil {
+				return nil, result.Err()
+			}
+			return result.Collect()
+		})
+	})
+}
+
 func (manager *TransactionManager) writeTransaction(txFunc TransactionFunction) (interface{}, error) {
 	session := manager.driver.NewSession(neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
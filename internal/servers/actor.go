@@ -0,0 +1,44 @@
+package servers
+
+import (
+	"context"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ActorUnaryInterceptor puts the caller identity into the context of every request, it is used
+// only if callers aren't authenticated. The identity is verified only if it comes from a client certificate.
+func ActorUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if commonName := verifiedCommonName(ctx); commonName != "" {
+		return handler(domain.WithActor(ctx, commonName), req)
+	}
+	return handler(domain.WithUnverifiedActor(ctx, claimedActor(ctx)), req)
+}
+
+// claimedActor returns the actor metadata, empty if the caller didn't send it
+func claimedActor(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(api.ActorMetadataKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// verifiedCommonName returns the common name of the caller's verified client certificate, if any
+func verifiedCommonName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return ""
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
@@ -1,6 +1,9 @@
 package servers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	"github.com/c12s/oort/internal/domain"
@@ -14,13 +17,20 @@ type AdministratorAsyncServer struct {
 	service    services.AdministrationService
 	publisher  messaging.Publisher
 	subscriber messaging.Subscriber
+	// whether the subscriber redelivers requests the handler failed to handle
+	redelivers bool
+	// nil if callers aren't authenticated, the actor header is trusted then
+	authenticator *Authenticator
 }
 
-func NewAdministratorAsyncServer(subscriber messaging.Subscriber, publisher messaging.Publisher, service services.AdministrationService) (*AdministratorAsyncServer, error) {
+func NewAdministratorAsyncServer(subscriber messaging.Subscriber, publisher messaging.Publisher, service services.AdministrationService,
+	redelivers bool, authenticator *Authenticator) (*AdministratorAsyncServer, error) {
 	return &AdministratorAsyncServer{
-		service:    service,
-		publisher:  publisher,
-		subscriber: subscriber,
+		service:       service,
+		publisher:     publisher,
+		subscriber:    subscriber,
+		redelivers:    redelivers,
+		authenticator: authenticator,
 	}, nil
 }
 
@@ -28,126 +38,177 @@ func (s *AdministratorAsyncServer) Serve() error {
 	return s.subscriber.Subscribe(s.serve)
 }
 
-func (s *AdministratorAsyncServer) serve(adminReqMarshalled []byte, replySubject string) {
+// serve returns an error only if the request should be redelivered or can never be handled
+func (s *AdministratorAsyncServer) serve(adminReqMarshalled []byte, replySubject string, header messaging.Header) error {
 	adminReq := &api.AdministrationAsyncReq{}
 	err := adminReq.Unmarshal(adminReqMarshalled)
 	if err != nil {
-		log.Println(err)
-		return
+		err = invalidRequest(err)
+		s.reply(invalidRequestResp(err), replySubject)
+		return err
 	}
+	ctx := domain.WithUnverifiedActor(context.Background(), header[api.ActorHeader])
+	if s.authenticator != nil {
+		actor, err := s.authenticator.AuthenticateHeader(header)
+		if err != nil {
+			resp, _ := proto.AdministrationAsyncRespFromDomain(domain.AdministrationResp{Error: err})
+			resp.CorrelationId = adminReq.CorrelationId
+			s.reply(resp, replySubject)
+			return nil
+		}
+		ctx = domain.WithActor(context.Background(), actor)
+	}
+	resp, err := s.handle(ctx, adminReq)
+	if errors.Is(err, messaging.ErrInvalidMessage) {
+		resp = invalidRequestResp(err)
+	} else if err != nil {
+		// the request won't be redelivered, so the requester is told it failed
+		if header[messaging.LastDeliveryHeader] == "true" {
+			resp, _ = proto.AdministrationAsyncRespFromDomain(domain.AdministrationResp{Error: err})
+			resp.CorrelationId = adminReq.CorrelationId
+			s.reply(resp, replySubject)
+		}
+		return err
+	}
+	resp.CorrelationId = adminReq.CorrelationId
+	s.reply(resp, replySubject)
+	return err
+}
+
+func (s *AdministratorAsyncServer) handle(ctx context.Context, adminReq *api.AdministrationAsyncReq) (*api.AdministrationAsyncResp, error) {
 	var domainResp domain.AdministrationResp
 	switch adminReq.Kind {
 	case api.AdministrationAsyncReq_CreateResource:
 		req := &api.CreateResourceReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.CreateResourceReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.CreateResource(*reqDomain)
+		domainResp = s.service.CreateResource(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_DeleteResource:
 		req := &api.DeleteResourceReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.DeleteResourceReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.DeleteResource(*reqDomain)
+		domainResp = s.service.DeleteResource(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_PutAttribute:
 		req := &api.PutAttributeReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.PutAttributeReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.PutAttribute(*reqDomain)
+		domainResp = s.service.PutAttribute(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_DeleteAttribute:
 		req := &api.DeleteAttributeReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.DeleteAttributeReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.DeleteAttribute(*reqDomain)
+		domainResp = s.service.DeleteAttribute(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_CreateInheritanceRel:
 		req := &api.CreateInheritanceRelReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.CreateInheritanceRelReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.CreateInheritanceRel(*reqDomain)
+		domainResp = s.service.CreateInheritanceRel(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_DeleteInheritanceRel:
 		req := &api.DeleteInheritanceRelReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.DeleteInheritanceRelReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.DeleteInheritanceRel(*reqDomain)
+		domainResp = s.service.DeleteInheritanceRel(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_CreatePolicy:
 		req := &api.CreatePolicyReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.CreatePolicyReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
-		domainResp = s.service.CreatePolicy(*reqDomain)
+		domainResp = s.service.CreatePolicy(ctx, *reqDomain)
 	case api.AdministrationAsyncReq_DeletePolicy:
 		req := &api.DeletePolicyReq{}
 		err := req.Unmarshal(adminReq.ReqMarshalled)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
 		}
 		reqDomain, err := proto.DeletePolicyReqToDomain(req)
 		if err != nil {
-			log.Println(err)
-			return
+			return nil, invalidRequest(err)
+		}
+		domainResp = s.service.DeletePolicy(ctx, *reqDomain)
+	case api.AdministrationAsyncReq_ApplyBatch:
+		req := &api.ApplyBatchReq{}
+		err := req.Unmarshal(adminReq.ReqMarshalled)
+		if err != nil {
+			return nil, invalidRequest(err)
+		}
+		reqDomain, err := proto.ApplyBatchReqToDomain(req)
+		if err != nil {
+			return nil, invalidRequest(err)
+		}
+		batchResp := s.service.ApplyBatch(ctx, *reqDomain)
+		if s.retryable(batchResp.Error) {
+			return nil, batchResp.Error
 		}
-		domainResp = s.service.DeletePolicy(*reqDomain)
+		return proto.ApplyBatchAsyncRespFromDomain(batchResp)
 	default:
-		log.Println("unknown request kind")
-		return
+		return nil, invalidRequest(errors.New("unknown request kind"))
 	}
-	resp, err := proto.AdministrationAsyncRespFromDomain(domainResp)
-	if err != nil {
-		log.Println(err)
+	if s.retryable(domainResp.Error) {
+		return nil, domainResp.Error
+	}
+	return proto.AdministrationAsyncRespFromDomain(domainResp)
+}
+
+func invalidRequest(err error) error {
+	return fmt.Errorf("%w: %v", messaging.ErrInvalidMessage, err)
+}
+
+func invalidRequestResp(err error) *api.AdministrationAsyncResp {
+	return &api.AdministrationAsyncResp{
+		Error:     err.Error(),
+		ErrorCode: api.ErrorCode_INVALID_ARGUMENT,
+	}
+}
+
+// retryable reports whether the request failed without reaching the repo
+// and should be redelivered instead of replied to
+func (s *AdministratorAsyncServer) retryable(err error) bool {
+	return s.redelivers && domain.KindOf(err) == domain.ErrKindUnavailable
+}
+
+func (s *AdministratorAsyncServer) reply(resp *api.AdministrationAsyncResp, replySubject string) {
+	if replySubject == "" {
 		return
 	}
 	respMarshalled, err := resp.Marshal()
@@ -2,6 +2,7 @@ package servers
 
 import (
 	"context"
+	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/internal/mappers/proto"
 	"github.com/c12s/oort/internal/services"
 	"github.com/c12s/oort/pkg/api"
@@ -21,71 +22,182 @@ func NewOortAdministratorGrpcServer(service services.AdministrationService) (api
 func (o *oortAdministratorGrpcServer) CreateResource(ctx context.Context, req *api.CreateResourceReq) (*api.AdministrationResp, error) {
 	request, err := proto.CreateResourceReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.CreateResource(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.CreateResource(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeleteResource(ctx context.Context, req *api.DeleteResourceReq) (*api.AdministrationResp, error) {
 	request, err := proto.DeleteResourceReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.DeleteResource(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.DeleteResource(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) CreateInheritanceRel(ctx context.Context, req *api.CreateInheritanceRelReq) (*api.AdministrationResp, error) {
 	request, err := proto.CreateInheritanceRelReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.CreateInheritanceRel(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.CreateInheritanceRel(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeleteInheritanceRel(ctx context.Context, req *api.DeleteInheritanceRelReq) (*api.AdministrationResp, error) {
 	request, err := proto.DeleteInheritanceRelReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.DeleteInheritanceRel(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.DeleteInheritanceRel(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) PutAttribute(ctx context.Context, req *api.PutAttributeReq) (*api.AdministrationResp, error) {
 	request, err := proto.PutAttributeReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.PutAttribute(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.PutAttribute(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeleteAttribute(ctx context.Context, req *api.DeleteAttributeReq) (*api.AdministrationResp, error) {
 	request, err := proto.DeleteAttributeReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.DeleteAttribute(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.DeleteAttribute(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) CreatePolicy(ctx context.Context, req *api.CreatePolicyReq) (*api.AdministrationResp, error) {
 	request, err := proto.CreatePolicyReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.CreatePolicy(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.CreatePolicy(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeletePolicy(ctx context.Context, req *api.DeletePolicyReq) (*api.AdministrationResp, error) {
 	request, err := proto.DeletePolicyReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
-	resp := o.service.DeletePolicy(*request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.DeletePolicy(ctx, *request)
+	return &api.AdministrationResp{}, proto.GrpcErrorFromDomain(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) GetResource(ctx context.Context, req *api.GetResourceReq) (*api.GetResourceResp, error) {
+	request, err := proto.GetResourceReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.GetResource(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.GetResourceRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) ListResources(ctx context.Context, req *api.ListResourcesReq) (*api.ListResourcesResp, error) {
+	request, err := proto.ListResourcesReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ListResources(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ListResourcesRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) ListChildren(ctx context.Context, req *api.ListChildrenReq) (*api.ListRelatedResourcesResp, error) {
+	request, err := proto.ListChildrenReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ListChildren(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ListRelatedResourcesRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) ListAncestors(ctx context.Context, req *api.ListAncestorsReq) (*api.ListRelatedResourcesResp, error) {
+	request, err := proto.ListAncestorsReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ListAncestors(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ListRelatedResourcesRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) ListPolicies(ctx context.Context, req *api.ListPoliciesReq) (*api.ListPoliciesResp, error) {
+	request, err := proto.ListPoliciesReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ListPolicies(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ListPoliciesRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) GetPoliciesForResource(ctx context.Context, req *api.GetPoliciesForResourceReq) (*api.GetPoliciesForResourceResp, error) {
+	request, err := proto.GetPoliciesForResourceReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.GetPoliciesForResource(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.GetPoliciesForResourceRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) ApplyBatch(ctx context.Context, req *api.ApplyBatchReq) (*api.ApplyBatchResp, error) {
+	request, err := proto.ApplyBatchReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ApplyBatch(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ApplyBatchRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) Watch(req *api.WatchReq, stream api.OortAdministrator_WatchServer) error {
+	request, err := proto.WatchReqToDomain(req)
+	if err != nil {
+		return proto.GrpcErrorFromDomain(err)
+	}
+	err = o.service.Watch(stream.Context(), *request, func(event domain.Event) error {
+		resp, err := proto.EventFromDomain(&event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	})
+	return proto.GrpcErrorFromDomain(err)
+}
+
+func (o *oortAdministratorGrpcServer) ListAuditRecords(ctx context.Context, req *api.ListAuditRecordsReq) (*api.ListAuditRecordsResp, error) {
+	request, err := proto.ListAuditRecordsReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ListAuditRecords(ctx, *request)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ListAuditRecordsRespFromDomain(&resp)
 }
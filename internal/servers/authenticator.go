@@ -0,0 +1,129 @@
+package servers
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/mappers/proto"
+	"github.com/c12s/oort/pkg/api"
+	"github.com/c12s/oort/pkg/messaging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const administratorMethodPrefix = "/proto.OortAdministrator/"
+
+// Authenticator identifies administration callers by the common name of their verified
+// client certificate or by a bearer token, and rejects calls from anyone else
+type Authenticator struct {
+	// subjects keyed by the sha256 hash of their token
+	tokens map[[sha256.Size]byte]string
+}
+
+// NewAuthenticator reads a file with a line per token, holding the subject and the hex encoded
+// sha256 hash of its token separated by whitespace. Empty lines and lines starting with # are skipped.
+func NewAuthenticator(tokensFile string) (*Authenticator, error) {
+	tokens := make(map[[sha256.Size]byte]string)
+	if tokensFile == "" {
+		return &Authenticator{tokens: tokens}, nil
+	}
+	file, err := os.Open(tokensFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("tokens file line %d: expected a subject and a token hash", lineNum)
+		}
+		hash, err := hex.DecodeString(fields[1])
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("tokens file line %d: invalid sha256 hash", lineNum)
+		}
+		tokens[[sha256.Size]byte(hash)] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &Authenticator{tokens: tokens}, nil
+}
+
+// UnaryInterceptor authenticates calls to the administrator and puts the caller into their context
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, administratorMethodPrefix) {
+		return handler(ctx, req)
+	}
+	actor, err := a.authenticateGrpc(ctx)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	return handler(domain.WithActor(ctx, actor), req)
+}
+
+// StreamInterceptor does the same as UnaryInterceptor for streaming calls
+func (a *Authenticator) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, administratorMethodPrefix) {
+		return handler(srv, stream)
+	}
+	actor, err := a.authenticateGrpc(stream.Context())
+	if err != nil {
+		return proto.GrpcErrorFromDomain(err)
+	}
+	return handler(srv, &actorServerStream{
+		ServerStream: stream,
+		ctx:          domain.WithActor(stream.Context(), actor),
+	})
+}
+
+// AuthenticateHeader authenticates async requests by the token in their authorization header
+func (a *Authenticator) AuthenticateHeader(header messaging.Header) (string, error) {
+	return a.authenticateToken(header[api.AuthorizationHeader])
+}
+
+func (a *Authenticator) authenticateGrpc(ctx context.Context) (string, error) {
+	if commonName := verifiedCommonName(ctx); commonName != "" {
+		return commonName, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", domain.ErrUnauthenticated
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", domain.ErrUnauthenticated
+	}
+	return a.authenticateToken(values[0])
+}
+
+func (a *Authenticator) authenticateToken(authorization string) (string, error) {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return "", domain.ErrUnauthenticated
+	}
+	subject, ok := a.tokens[sha256.Sum256([]byte(token))]
+	if !ok {
+		return "", domain.ErrUnauthenticated
+	}
+	return subject, nil
+}
+
+type actorServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *actorServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,115 @@
+package servers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/c12s/oort/internal/mappers/proto"
+	"github.com/c12s/oort/internal/services"
+	"github.com/c12s/oort/pkg/api"
+	"github.com/c12s/oort/pkg/messaging"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type EvaluatorAsyncServer struct {
+	service    services.EvaluationService
+	publisher  messaging.Publisher
+	subscriber messaging.Subscriber
+}
+
+func NewEvaluatorAsyncServer(subscriber messaging.Subscriber, publisher messaging.Publisher, service services.EvaluationService) (*EvaluatorAsyncServer, error) {
+	return &EvaluatorAsyncServer{
+		service:    service,
+		publisher:  publisher,
+		subscriber: subscriber,
+	}, nil
+}
+
+func (s *EvaluatorAsyncServer) Serve() error {
+	return s.subscriber.Subscribe(s.serve)
+}
+
+func (s *EvaluatorAsyncServer) serve(evalReqMarshalled []byte, replySubject string, _ messaging.Header) error {
+	evalReq := &api.EvaluationAsyncReq{}
+	err := evalReq.Unmarshal(evalReqMarshalled)
+	if err != nil {
+		err = invalidRequest(err)
+		s.reply(&api.EvaluationAsyncResp{Error: err.Error(), ErrorCode: api.ErrorCode_INVALID_ARGUMENT}, replySubject)
+		return err
+	}
+	resp, err := s.handle(evalReq)
+	var evalResp *api.EvaluationAsyncResp
+	if errors.Is(err, messaging.ErrInvalidMessage) {
+		evalResp = &api.EvaluationAsyncResp{Error: err.Error(), ErrorCode: api.ErrorCode_INVALID_ARGUMENT}
+	} else if err != nil {
+		evalResp = &api.EvaluationAsyncResp{Error: err.Error(), ErrorCode: proto.ErrorCodeFromDomain(err)}
+	} else {
+		respMarshalled, marshalErr := protobuf.Marshal(resp)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		evalResp = &api.EvaluationAsyncResp{RespMarshalled: respMarshalled}
+	}
+	evalResp.CorrelationId = evalReq.CorrelationId
+	s.reply(evalResp, replySubject)
+	return err
+}
+
+func (s *EvaluatorAsyncServer) handle(evalReq *api.EvaluationAsyncReq) (protobuf.Message, error) {
+	switch evalReq.Kind {
+	case api.EvaluationAsyncReq_Authorize:
+		req := &api.AuthorizationReq{}
+		err := req.Unmarshal(evalReq.ReqMarshalled)
+		if err != nil {
+			return nil, invalidRequest(err)
+		}
+		reqDomain, err := proto.AuthorizationReqToDomain(req)
+		if err != nil {
+			return nil, invalidRequest(err)
+		}
+		resp := s.service.Authorize(*reqDomain)
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+		return proto.AuthorizationRespFromDomain(&resp)
+	case api.EvaluationAsyncReq_GetGrantedPermissions:
+		req := &api.GetGrantedPermissionsReq{}
+		err := req.Unmarshal(evalReq.ReqMarshalled)
+		if err != nil {
+			return nil, invalidRequest(err)
+		}
+		reqDomain, err := proto.GetGrantedPermissionsReqToDomain(req)
+		if err != nil {
+			return nil, invalidRequest(err)
+		}
+		resp := s.service.GetGrantedPermissions(*reqDomain)
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+		return proto.GetGrantedPermissionsRespFromDomain(&resp)
+	default:
+		return nil, invalidRequest(errors.New("unknown request kind"))
+	}
+}
+
+func (s *EvaluatorAsyncServer) reply(resp *api.EvaluationAsyncResp, replySubject string) {
+	if replySubject == "" {
+		return
+	}
+	respMarshalled, err := resp.Marshal()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = s.publisher.Publish(respMarshalled, replySubject)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *EvaluatorAsyncServer) GracefulStop() {
+	err := s.subscriber.Unsubscribe()
+	if err != nil {
+		log.Println(err)
+	}
+}
@@ -22,20 +22,59 @@ func NewOortEvaluatorGrpcServer(service services.EvaluationService) (api.OortEva
 func (o *oortEvaluatorGrpcServer) Authorize(ctx context.Context, req *api.AuthorizationReq) (*api.AuthorizationResp, error) {
 	reqDomain, err := proto.AuthorizationReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
 	resp := o.service.Authorize(*reqDomain)
-	return &api.AuthorizationResp{Authorized: resp.Authorized}, resp.Error
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.AuthorizationRespFromDomain(&resp)
+}
+
+func (o *oortEvaluatorGrpcServer) AuthorizeBatch(ctx context.Context, req *api.AuthorizationBatchReq) (*api.AuthorizationBatchResp, error) {
+	reqDomain, err := proto.AuthorizationBatchReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.AuthorizeBatch(*reqDomain)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.AuthorizationBatchRespFromDomain(&resp)
 }
 
 func (o *oortEvaluatorGrpcServer) GetGrantedPermissions(ctx context.Context, req *api.GetGrantedPermissionsReq) (*api.GetGrantedPermissionsResp, error) {
 	reqDomain, err := proto.GetGrantedPermissionsReqToDomain(req)
 	if err != nil {
-		return nil, err
+		return nil, proto.GrpcErrorFromDomain(err)
 	}
 	resp := o.service.GetGrantedPermissions(*reqDomain)
 	if resp.Error != nil {
-		return nil, resp.Error
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
 	}
 	return proto.GetGrantedPermissionsRespFromDomain(&resp)
 }
+
+func (o *oortEvaluatorGrpcServer) GetAuthorizedSubjects(ctx context.Context, req *api.GetAuthorizedSubjectsReq) (*api.GetAuthorizedSubjectsResp, error) {
+	reqDomain, err := proto.GetAuthorizedSubjectsReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.GetAuthorizedSubjects(*reqDomain)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.GetAuthorizedSubjectsRespFromDomain(&resp)
+}
+
+func (o *oortEvaluatorGrpcServer) ListAccessibleObjects(ctx context.Context, req *api.ListAccessibleObjectsReq) (*api.ListAccessibleObjectsResp, error) {
+	reqDomain, err := proto.ListAccessibleObjectsReqToDomain(req)
+	if err != nil {
+		return nil, proto.GrpcErrorFromDomain(err)
+	}
+	resp := o.service.ListAccessibleObjects(*reqDomain)
+	if resp.Error != nil {
+		return nil, proto.GrpcErrorFromDomain(resp.Error)
+	}
+	return proto.ListAccessibleObjectsRespFromDomain(&resp)
+}
@@ -1,59 +1,270 @@
 package services
 
 import (
+	"context"
+	"time"
+
 	"github.com/c12s/oort/internal/domain"
 )
 
+const (
+	watchPollInterval = 500 * time.Millisecond
+	watchBatchSize    = 100
+)
+
+// AdministrationService changes the graph, the repo records a change event
+// for every change in the same transaction
 type AdministrationService struct {
 	repo domain.RHABACRepo
+	// nil if callers aren't authorized
+	authorizer *AdministrationAuthorizer
 }
 
-func NewAdministrationService(repo domain.RHABACRepo) (*AdministrationService, error) {
+func NewAdministrationService(repo domain.RHABACRepo, authorizer *AdministrationAuthorizer) (*AdministrationService, error) {
 	return &AdministrationService{
-		repo: repo,
+		repo:       repo,
+		authorizer: authorizer,
 	}, nil
 }
 
-func (h AdministrationService) CreateResource(req domain.CreateResourceReq) domain.AdministrationResp {
-	return h.repo.CreateResource(req)
+func (h AdministrationService) CreateResource(ctx context.Context, req domain.CreateResourceReq) domain.AdministrationResp {
+	return h.apply(ctx, req)
+}
+
+func (h AdministrationService) DeleteResource(ctx context.Context, req domain.DeleteResourceReq) domain.AdministrationResp {
+	return h.apply(ctx, req)
+}
+
+func (h AdministrationService) GetResource(ctx context.Context, req domain.GetResourceReq) domain.GetResourceResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.GetResourceResp{Error: err}
+	}
+	return h.repo.GetResource(req)
+}
+
+func (h AdministrationService) ListResources(ctx context.Context, req domain.ListResourcesReq) domain.ListResourcesResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.ListResourcesResp{Error: err}
+	}
+	pageSize := domain.PageSize(req.PageSize)
+	resp := h.repo.GetResources(domain.GetResourcesReq{
+		Kind:  req.Kind,
+		After: req.PageToken,
+		Limit: pageSize,
+	})
+	if resp.Error != nil {
+		return domain.ListResourcesResp{Error: resp.Error}
+	}
+	nextPageToken := ""
+	if len(resp.Resources) == pageSize {
+		nextPageToken = resp.Resources[pageSize-1].Name()
+	}
+	return domain.ListResourcesResp{
+		Resources:     resp.Resources,
+		NextPageToken: nextPageToken,
+	}
+}
+
+func (h AdministrationService) ListChildren(ctx context.Context, req domain.ListChildrenReq) domain.ListRelatedResourcesResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.ListRelatedResourcesResp{Error: err}
+	}
+	req.PageSize = domain.PageSize(req.PageSize)
+	return h.repo.GetChildren(req)
+}
+
+func (h AdministrationService) ListAncestors(ctx context.Context, req domain.ListAncestorsReq) domain.ListRelatedResourcesResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.ListRelatedResourcesResp{Error: err}
+	}
+	req.PageSize = domain.PageSize(req.PageSize)
+	return h.repo.GetAncestors(req)
+}
+
+func (h AdministrationService) PutAttribute(ctx context.Context, req domain.PutAttributeReq) domain.AdministrationResp {
+	return h.apply(ctx, req)
 }
 
-func (h AdministrationService) DeleteResource(req domain.DeleteResourceReq) domain.AdministrationResp {
-	return h.repo.DeleteResource(req)
+func (h AdministrationService) DeleteAttribute(ctx context.Context, req domain.DeleteAttributeReq) domain.AdministrationResp {
+	return h.apply(ctx, req)
 }
 
-func (h AdministrationService) PutAttribute(req domain.PutAttributeReq) domain.AdministrationResp {
-	return h.repo.PutAttribute(req)
+func (h AdministrationService) CreateInheritanceRel(ctx context.Context, req domain.CreateInheritanceRelReq) domain.AdministrationResp {
+	return h.apply(ctx, req)
 }
 
-func (h AdministrationService) DeleteAttribute(req domain.DeleteAttributeReq) domain.AdministrationResp {
-	return h.repo.DeleteAttribute(req)
+func (h AdministrationService) DeleteInheritanceRel(ctx context.Context, req domain.DeleteInheritanceRelReq) domain.AdministrationResp {
+	return h.apply(ctx, req)
 }
 
-func (h AdministrationService) CreateInheritanceRel(req domain.CreateInheritanceRelReq) domain.AdministrationResp {
-	return h.repo.CreateInheritanceRel(req)
+func (h AdministrationService) CreatePolicy(ctx context.Context, req domain.CreatePolicyReq) domain.AdministrationResp {
+	req.SubjectScope = defaultScope(req.SubjectScope)
+	req.ObjectScope = defaultScope(req.ObjectScope)
+	return h.apply(ctx, req)
 }
 
-func (h AdministrationService) DeleteInheritanceRel(req domain.DeleteInheritanceRelReq) domain.AdministrationResp {
-	return h.repo.DeleteInheritanceRel(req)
+func (h AdministrationService) DeletePolicy(ctx context.Context, req domain.DeletePolicyReq) domain.AdministrationResp {
+	req.SubjectScope = defaultScope(req.SubjectScope)
+	req.ObjectScope = defaultScope(req.ObjectScope)
+	return h.apply(ctx, req)
 }
 
-func (h AdministrationService) CreatePolicy(req domain.CreatePolicyReq) domain.AdministrationResp {
-	if req.SubjectScope.Name() == "" {
-		req.SubjectScope = domain.RootResource
+// apply applies a single operation as a batch, the only way the repo changes the graph
+func (h AdministrationService) apply(ctx context.Context, op domain.AdministrationOp) domain.AdministrationResp {
+	err := h.authorize(ctx, op)
+	if err != nil {
+		return domain.AdministrationResp{Error: err}
 	}
-	if req.ObjectScope.Name() == "" {
-		req.ObjectScope = domain.RootResource
+	resp := h.repo.ApplyBatch(domain.ApplyBatchReq{
+		Operations:    []domain.AdministrationOp{op},
+		Actor:         domain.ActorFromContext(ctx),
+		ActorVerified: domain.ActorVerifiedFromContext(ctx),
+	})
+	if resp.Error != nil {
+		return domain.AdministrationResp{Error: resp.Error}
 	}
-	return h.repo.CreatePolicy(req)
+	return resp.Results[0]
 }
 
-func (h AdministrationService) DeletePolicy(req domain.DeletePolicyReq) domain.AdministrationResp {
-	if req.SubjectScope.Name() == "" {
-		req.SubjectScope = domain.RootResource
+// ApplyBatch applies all operations or none of them
+func (h AdministrationService) ApplyBatch(ctx context.Context, req domain.ApplyBatchReq) domain.ApplyBatchResp {
+	operations := make([]domain.AdministrationOp, len(req.Operations))
+	for i, op := range req.Operations {
+		switch policyReq := op.(type) {
+		case domain.CreatePolicyReq:
+			policyReq.SubjectScope = defaultScope(policyReq.SubjectScope)
+			policyReq.ObjectScope = defaultScope(policyReq.ObjectScope)
+			op = policyReq
+		case domain.DeletePolicyReq:
+			policyReq.SubjectScope = defaultScope(policyReq.SubjectScope)
+			policyReq.ObjectScope = defaultScope(policyReq.ObjectScope)
+			op = policyReq
+		}
+		operations[i] = op
+	}
+	for i, op := range operations {
+		err := h.authorize(ctx, op)
+		if err == nil {
+			continue
+		}
+		results := make([]domain.AdministrationResp, len(operations))
+		for j := range results {
+			results[j].Error = domain.ErrBatchAborted
+		}
+		results[i].Error = err
+		return domain.ApplyBatchResp{Results: results, Error: nil}
 	}
-	if req.ObjectScope.Name() == "" {
-		req.ObjectScope = domain.RootResource
+	return h.repo.ApplyBatch(domain.ApplyBatchReq{
+		Operations:    operations,
+		Actor:         domain.ActorFromContext(ctx),
+		ActorVerified: domain.ActorVerifiedFromContext(ctx),
+	})
+}
+
+func (h AdministrationService) authorize(ctx context.Context, req domain.AdministrationReq) error {
+	if h.authorizer == nil {
+		return nil
+	}
+	// a claimed identity is never enough to be allowed anything
+	if !domain.ActorVerifiedFromContext(ctx) {
+		return domain.ErrUnauthenticated
+	}
+	return h.authorizer.Authorize(domain.ActorFromContext(ctx), req)
+}
+
+// policies without a scope apply to all resources
+func defaultScope(scope domain.Resource) domain.Resource {
+	if scope.Name() == "" {
+		return domain.RootResource
+	}
+	return scope
+}
+
+func (h AdministrationService) ListPolicies(ctx context.Context, req domain.ListPoliciesReq) domain.ListPoliciesResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.ListPoliciesResp{Error: err}
+	}
+	req.PageSize = domain.PageSize(req.PageSize)
+	return h.repo.GetPolicies(req)
+}
+
+func (h AdministrationService) GetPoliciesForResource(ctx context.Context, req domain.GetPoliciesForResourceReq) domain.GetPoliciesForResourceResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.GetPoliciesForResourceResp{Error: err}
+	}
+	resp := h.repo.GetInheritedPolicies(req)
+	if resp.Error != nil || len(resp.Policies) > 0 {
+		return resp
+	}
+	// tell apart a resource without policies from one that doesn't exist
+	resourceResp := h.repo.GetResource(domain.GetResourceReq{Resource: req.Resource})
+	if resourceResp.Error != nil {
+		return domain.GetPoliciesForResourceResp{Error: resourceResp.Error}
+	}
+	return resp
+}
+
+func (h AdministrationService) ListAuditRecords(ctx context.Context, req domain.ListAuditRecordsReq) domain.ListAuditRecordsResp {
+	if err := h.authorize(ctx, req); err != nil {
+		return domain.ListAuditRecordsResp{Error: err}
+	}
+	req.PageSize = domain.PageSize(req.PageSize)
+	return h.repo.GetAuditRecords(req)
+}
+
+// Watch sends the events that match the request until the context is done or sending fails.
+// Events are read from the outbox, so replayed and new changes arrive in a single revision order.
+func (h AdministrationService) Watch(ctx context.Context, req domain.WatchReq, send func(event domain.Event) error) error {
+	if err := h.authorize(ctx, req); err != nil {
+		return err
+	}
+	revisionResp := h.repo.GetRevision()
+	if revisionResp.Error != nil {
+		return revisionResp.Error
+	}
+	after := revisionResp.Revision
+	if req.FromRevision != nil {
+		if *req.FromRevision > revisionResp.Revision {
+			return domain.NewError(domain.ErrKindInvalidArgument, "revision is newer than the current one")
+		}
+		after = *req.FromRevision
+	}
+	// the first missed event must still be retained, otherwise the client would skip changes
+	checkRetained := after < revisionResp.Revision
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		resp := h.repo.GetEvents(domain.GetEventsReq{
+			AfterRevision: after,
+			Limit:         watchBatchSize,
+		})
+		if resp.Error != nil {
+			return resp.Error
+		}
+		if checkRetained {
+			if len(resp.Events) == 0 || resp.Events[0].Revision != after+1 {
+				return domain.ErrRevisionCompacted
+			}
+			checkRetained = false
+		}
+		for _, event := range resp.Events {
+			after = event.Revision
+			if !req.Matches(event) {
+				continue
+			}
+			err := send(event)
+			if err != nil {
+				return err
+			}
+		}
+		if len(resp.Events) == watchBatchSize && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
 	}
-	return h.repo.DeletePolicy(req)
 }
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"github.com/c12s/oort/internal/domain"
+)
+
+// AdministrationAuthorizer allows an administration request only to callers allowed the request's
+// permission on all of its scopes. Superusers are allowed everything, so that the first policies can be created.
+type AdministrationAuthorizer struct {
+	repo       domain.RHABACRepo
+	evaluation EvaluationService
+	superusers map[string]bool
+}
+
+func NewAdministrationAuthorizer(repo domain.RHABACRepo, evaluation EvaluationService, superusers []string) (*AdministrationAuthorizer, error) {
+	superuserSet := make(map[string]bool)
+	for _, superuser := range superusers {
+		superuserSet[superuser] = true
+	}
+	return &AdministrationAuthorizer{
+		repo:       repo,
+		evaluation: evaluation,
+		superusers: superuserSet,
+	}, nil
+}
+
+// Authorize checks the request on behalf of the actor, whose identity must be a resource name.
+// Scopes that an operation would create are checked against the root, the only resource they would inherit from.
+func (a AdministrationAuthorizer) Authorize(actor string, req domain.AdministrationReq) error {
+	if actor == "" {
+		return domain.ErrUnauthenticated
+	}
+	if a.superusers[actor] {
+		return nil
+	}
+	subject, err := domain.NewResourceFromName(actor)
+	if err != nil {
+		return domain.ErrPermissionDenied
+	}
+	permission, requiredScopes := req.RequiredPermission()
+	scopes := make([]domain.Resource, len(requiredScopes))
+	copy(scopes, requiredScopes)
+	if merger, ok := req.(domain.ResourceMerger); ok {
+		missing, err := a.missingResources(merger.MergedResources())
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			err = a.check(*subject, domain.PermissionResourceCreate, domain.RootResource)
+			if err != nil {
+				return err
+			}
+			for i, scope := range scopes {
+				if missing[scope.Name()] {
+					scopes[i] = domain.RootResource
+				}
+			}
+		}
+	}
+	for _, scope := range scopes {
+		err = a.check(*subject, permission, scope)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a AdministrationAuthorizer) check(subject domain.Resource, permission string, scope domain.Resource) error {
+	resp := a.evaluation.Authorize(domain.AuthorizationReq{
+		Subject:        subject,
+		Object:         scope,
+		PermissionName: permission,
+	})
+	// a caller or scope that doesn't exist can't have been allowed anything
+	if domain.KindOf(resp.Error) == domain.ErrKindNotFound {
+		return domain.ErrPermissionDenied
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if !resp.Authorized {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (a AdministrationAuthorizer) missingResources(resources []domain.Resource) (map[string]bool, error) {
+	missing := make(map[string]bool)
+	for _, resource := range resources {
+		resp := a.repo.GetResource(domain.GetResourceReq{Resource: resource})
+		if domain.KindOf(resp.Error) == domain.ErrKindNotFound {
+			missing[resource.Name()] = true
+			continue
+		}
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+	}
+	return missing, nil
+}
@@ -2,21 +2,49 @@ package services
 
 import (
 	"log"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
 )
 
 type EvaluationService struct {
 	repo domain.RHABACRepo
+	// records every authorization check, nil if decisions aren't logged
+	decisionLogger domain.DecisionLogger
 }
 
-func NewEvaluationService(repo domain.RHABACRepo) (*EvaluationService, error) {
+func NewEvaluationService(repo domain.RHABACRepo, decisionLogger domain.DecisionLogger) (*EvaluationService, error) {
 	return &EvaluationService{
-		repo: repo,
+		repo:           repo,
+		decisionLogger: decisionLogger,
 	}, nil
 }
 
 func (h EvaluationService) Authorize(req domain.AuthorizationReq) domain.AuthorizationResp {
+	start := time.Now()
+	resp, decision := h.authorize(req, make(attributeCache))
+	h.logDecision(req, resp, decision, start)
+	return resp
+}
+
+// AuthorizeBatch evaluates all checks and returns their results in request order.
+// Attributes of subjects and objects that appear in several checks are read only once.
+func (h EvaluationService) AuthorizeBatch(req domain.AuthorizationBatchReq) domain.AuthorizationBatchResp {
+	attrs := make(attributeCache)
+	results := make([]domain.AuthorizationResp, len(req.Checks))
+	for i, check := range req.Checks {
+		start := time.Now()
+		resp, decision := h.authorize(check, attrs)
+		h.logDecision(check, resp, decision, start)
+		results[i] = resp
+	}
+	return domain.AuthorizationBatchResp{
+		Results: results,
+		Error:   nil,
+	}
+}
+
+func (h EvaluationService) authorize(req domain.AuthorizationReq, attrs attributeCache) (domain.AuthorizationResp, domain.Decision) {
 	resp := h.repo.GetPermissionHierarchy(domain.GetPermissionHierarchyReq{
 		Subject:        req.Subject,
 		Object:         req.Object,
@@ -26,22 +54,22 @@ func (h EvaluationService) Authorize(req domain.AuthorizationReq) domain.Authori
 		return domain.AuthorizationResp{
 			Authorized: false,
 			Error:      resp.Error,
-		}
+		}, domain.Decision{}
 	}
 
-	subAttrs, err := h.getAttributes(req.Subject)
+	subAttrs, err := h.getCachedAttributes(req.Subject, attrs)
 	if err != nil {
 		return domain.AuthorizationResp{
 			Authorized: false,
 			Error:      err,
-		}
+		}, domain.Decision{}
 	}
-	objAttrs, err := h.getAttributes(req.Object)
+	objAttrs, err := h.getCachedAttributes(req.Object, attrs)
 	if err != nil {
 		return domain.AuthorizationResp{
 			Authorized: false,
 			Error:      err,
-		}
+		}, domain.Decision{}
 	}
 
 	evalReq := domain.PermissionEvalRequest{
@@ -49,14 +77,43 @@ func (h EvaluationService) Authorize(req domain.AuthorizationReq) domain.Authori
 		Object:  objAttrs,
 		Env:     req.Env,
 	}
-	evalResult := resp.Hierarchy.Eval(evalReq)
+	if req.Explain {
+		explanation := resp.Hierarchy.Explain(evalReq)
+		return domain.AuthorizationResp{
+			Authorized:  authorized(explanation.Result),
+			Explanation: &explanation,
+			Error:       nil,
+		}, explanation.Decision()
+	}
+	decision := resp.Hierarchy.Decide(evalReq)
 
 	checkResp := domain.AuthorizationResp{
-		Authorized: authorized(evalResult),
+		Authorized: authorized(decision.Result),
 		Error:      nil,
 	}
 
-	return checkResp
+	return checkResp, decision
+}
+
+// logDecision records the check, a failure to record it doesn't affect the response
+func (h EvaluationService) logDecision(req domain.AuthorizationReq, resp domain.AuthorizationResp, decision domain.Decision, start time.Time) {
+	if h.decisionLogger == nil {
+		return
+	}
+	err := h.decisionLogger.Log(domain.DecisionRecord{
+		Timestamp:      start,
+		Subject:        req.Subject,
+		Object:         req.Object,
+		PermissionName: req.PermissionName,
+		Env:            req.Env,
+		Authorized:     resp.Authorized,
+		Error:          resp.Error,
+		Latency:        time.Since(start),
+		Decision:       decision,
+	})
+	if err != nil {
+		log.Println(err)
+	}
 }
 
 func (h EvaluationService) GetGrantedPermissions(req domain.GetGrantedPermissionsReq) domain.GetGrantedPermissionsResp {
@@ -73,7 +130,7 @@ func (h EvaluationService) GetGrantedPermissions(req domain.GetGrantedPermission
 
 	subAttrs, err := h.getAttributes(req.Subject)
 	if err != nil {
-		return domain.GetGrantedPermissionsResp{Error: resp.Error}
+		return domain.GetGrantedPermissionsResp{Error: err}
 	}
 	// proveravamo nad vise objekata, svaki objekat je element u mapi
 	objAttrMap := make(map[string][]domain.Attribute)
@@ -120,6 +177,170 @@ func (h EvaluationService) GetGrantedPermissions(req domain.GetGrantedPermission
 	}
 }
 
+// GetAuthorizedSubjects returns the subjects that are currently allowed the permission on the object
+func (h EvaluationService) GetAuthorizedSubjects(req domain.GetAuthorizedSubjectsReq) domain.GetAuthorizedSubjectsResp {
+	candidates := func(after string, limit int) ([]domain.Resource, error) {
+		resp := h.repo.GetSubjectCandidates(domain.GetSubjectCandidatesReq{
+			Object:         req.Object,
+			PermissionName: req.PermissionName,
+			SubjectKind:    req.SubjectKind,
+			After:          after,
+			Limit:          limit,
+		})
+		return resp.Subjects, resp.Error
+	}
+	check := func(candidate domain.Resource) domain.AuthorizationReq {
+		return domain.AuthorizationReq{
+			Subject:        candidate,
+			Object:         req.Object,
+			PermissionName: req.PermissionName,
+			Env:            req.Env,
+		}
+	}
+	subjects, nextPageToken, err := h.authorizedPage(candidates, check, req.PageSize, req.PageToken)
+	return domain.GetAuthorizedSubjectsResp{
+		Subjects:      subjects,
+		NextPageToken: nextPageToken,
+		Error:         err,
+	}
+}
+
+// ListAccessibleObjects returns the objects the subject is currently allowed the permission on
+func (h EvaluationService) ListAccessibleObjects(req domain.ListAccessibleObjectsReq) domain.ListAccessibleObjectsResp {
+	candidates := func(after string, limit int) ([]domain.Resource, error) {
+		resp := h.repo.GetObjectCandidates(domain.GetObjectCandidatesReq{
+			Subject:        req.Subject,
+			PermissionName: req.PermissionName,
+			ObjectKind:     req.ObjectKind,
+			After:          after,
+			Limit:          limit,
+		})
+		return resp.Objects, resp.Error
+	}
+	check := func(candidate domain.Resource) domain.AuthorizationReq {
+		return domain.AuthorizationReq{
+			Subject:        req.Subject,
+			Object:         candidate,
+			PermissionName: req.PermissionName,
+			Env:            req.Env,
+		}
+	}
+	objects, nextPageToken, err := h.authorizedPage(candidates, check, req.PageSize, req.PageToken)
+	return domain.ListAccessibleObjectsResp{
+		Objects:       objects,
+		NextPageToken: nextPageToken,
+		Error:         err,
+	}
+}
+
+// maxScannedCandidates bounds the work of a single request, if it is reached before a page is filled,
+// the page is returned short along with a page token to continue from
+const maxScannedCandidates = 1000
+
+// authorizedPage reads candidates in name order and evaluates them a batch at a time until a page of authorized
+// ones is filled. The page token is the name of the last evaluated candidate, so pages stay stable while the graph changes.
+func (h EvaluationService) authorizedPage(candidates func(after string, limit int) ([]domain.Resource, error),
+	check func(candidate domain.Resource) domain.AuthorizationReq, pageSize int, pageToken string) ([]domain.Resource, string, error) {
+	pageSize = domain.PageSize(pageSize)
+	page := make([]domain.Resource, 0)
+	attrs := make(attributeCache)
+	after := pageToken
+	scanned := 0
+	for {
+		batch, err := candidates(after, pageSize)
+		if err != nil {
+			return nil, "", err
+		}
+		checks := make([]domain.AuthorizationReq, len(batch))
+		for i, candidate := range batch {
+			checks[i] = check(candidate)
+		}
+		authorized, err := h.authorizeBatch(checks, attrs)
+		if err != nil {
+			return nil, "", err
+		}
+		for i, candidate := range batch {
+			after = candidate.Name()
+			scanned++
+			if authorized[i] {
+				page = append(page, candidate)
+			}
+			if len(page) == pageSize || scanned == maxScannedCandidates {
+				return page, after, nil
+			}
+		}
+		if len(batch) < pageSize {
+			return page, "", nil
+		}
+	}
+}
+
+// authorizeBatch evaluates the checks with their hierarchies and attributes read in two queries.
+// Checks that refer to resources deleted since they were read aren't authorized.
+func (h EvaluationService) authorizeBatch(checks []domain.AuthorizationReq, attrs attributeCache) ([]bool, error) {
+	hierarchyReqs := make([]domain.GetPermissionHierarchyReq, len(checks))
+	resources := make([]domain.Resource, 0, 2*len(checks))
+	for i, check := range checks {
+		hierarchyReqs[i] = domain.GetPermissionHierarchyReq{
+			Subject:        check.Subject,
+			Object:         check.Object,
+			PermissionName: check.PermissionName,
+		}
+		resources = append(resources, check.Subject, check.Object)
+	}
+	hierarchiesResp := h.repo.GetPermissionHierarchies(domain.GetPermissionHierarchiesReq{Checks: hierarchyReqs})
+	if hierarchiesResp.Error != nil {
+		return nil, hierarchiesResp.Error
+	}
+	err := h.loadAttributes(resources, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizedChecks := make([]bool, len(checks))
+	for i, check := range checks {
+		subAttrs, subErr := h.getCachedAttributes(check.Subject, attrs)
+		objAttrs, objErr := h.getCachedAttributes(check.Object, attrs)
+		if subErr != nil || objErr != nil {
+			continue
+		}
+		result := hierarchiesResp.Hierarchies[i].Eval(domain.PermissionEvalRequest{
+			Subject: subAttrs,
+			Object:  objAttrs,
+			Env:     check.Env,
+		})
+		authorizedChecks[i] = authorized(result)
+	}
+	return authorizedChecks, nil
+}
+
+// loadAttributes reads the attributes of all resources that aren't cached yet in a single query,
+// resources that don't exist are cached as not found
+func (h EvaluationService) loadAttributes(resources []domain.Resource, cache attributeCache) error {
+	missing := make([]domain.Resource, 0)
+	for _, resource := range resources {
+		if _, ok := cache[resource.Name()]; ok {
+			continue
+		}
+		cache[resource.Name()] = cachedAttributes{err: domain.ErrResourceNotFound}
+		missing = append(missing, resource)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	resp := h.repo.GetResourcesWithAttributes(domain.GetResourcesWithAttributesReq{Resources: missing})
+	if resp.Error != nil {
+		for _, resource := range missing {
+			delete(cache, resource.Name())
+		}
+		return resp.Error
+	}
+	for _, resource := range resp.Resources {
+		cache[resource.Name()] = cachedAttributes{attrs: resource.Attributes}
+	}
+	return nil
+}
+
 func (h EvaluationService) getAttributes(resource domain.Resource) ([]domain.Attribute, error) {
 	res := h.repo.GetResource(domain.GetResourceReq{Resource: resource})
 	if res.Error != nil {
@@ -128,6 +349,23 @@ func (h EvaluationService) getAttributes(resource domain.Resource) ([]domain.Att
 	return res.Resource.Attributes, nil
 }
 
+type cachedAttributes struct {
+	attrs []domain.Attribute
+	err   error
+}
+
+// attributeCache maps resource names to their attributes, including failed lookups
+type attributeCache map[string]cachedAttributes
+
+func (h EvaluationService) getCachedAttributes(resource domain.Resource, cache attributeCache) ([]domain.Attribute, error) {
+	if cached, ok := cache[resource.Name()]; ok {
+		return cached.attrs, cached.err
+	}
+	attrs, err := h.getAttributes(resource)
+	cache[resource.Name()] = cachedAttributes{attrs: attrs, err: err}
+	return attrs, err
+}
+
 func authorized(result domain.EvalResult) bool {
 	return result == domain.EvalResultAllowed
 }
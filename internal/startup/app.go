@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"sync"
 
 	"github.com/c12s/oort/internal/configs"
+	"github.com/c12s/oort/internal/decisions"
 	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/events"
 	"github.com/c12s/oort/internal/repos/rhabac/neo4j"
 	"github.com/c12s/oort/internal/servers"
 	"github.com/c12s/oort/internal/services"
@@ -25,12 +28,18 @@ type app struct {
 	config                    configs.Config
 	grpcServer                *grpc.Server
 	administratorAsyncServer  *servers.AdministratorAsyncServer
+	evaluatorAsyncServer      *servers.EvaluatorAsyncServer
 	administratorGrpcServer   api.OortAdministratorServer
 	evaluatorGrpcServer       api.OortEvaluatorServer
 	administrationService     *services.AdministrationService
 	evaluationService         *services.EvaluationService
+	authenticator             *servers.Authenticator
 	publisher                 messaging.Publisher
+	eventPublisher            domain.EventPublisher
+	decisionLogger            domain.DecisionLogger
+	outboxRelay               *outboxRelay
 	administratorSubscriber   messaging.Subscriber
+	evaluatorSubscriber       messaging.Subscriber
 	rhabacRepo                domain.RHABACRepo
 	shutdownProcesses         []func()
 	gracefulShutdownProcesses []func(wg *sync.WaitGroup)
@@ -50,10 +59,15 @@ func NewAppWithConfig(config configs.Config) (*app, error) {
 func (a *app) Start() error {
 	a.init()
 
+	a.startOutboxRelay()
 	err := a.startAdministratorAsyncServer()
 	if err != nil {
 		return err
 	}
+	err = a.startEvaluatorAsyncServer()
+	if err != nil {
+		return err
+	}
 	return a.startGrpcServer()
 }
 
@@ -86,7 +100,7 @@ func (a *app) GracefulStop(ctx context.Context) {
 }
 
 func (a *app) init() {
-	natsConn, err := newNatsConn(a.config.Nats().Uri())
+	natsConn, err := newNatsConn(a.config.Nats())
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -95,9 +109,15 @@ func (a *app) init() {
 		natsConn.Close()
 	})
 
+	neo4jConfigurers, err := newNeo4jConfigurers(a.config.Neo4j())
+	if err != nil {
+		log.Fatalln(err)
+	}
 	manager, err := neo4j.NewTransactionManager(
 		a.config.Neo4j().Uri(),
-		a.config.Neo4j().DbName())
+		a.config.Neo4j().DbName(),
+		newNeo4jAuth(a.config.Neo4j()),
+		neo4jConfigurers...)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -105,16 +125,27 @@ func (a *app) init() {
 		log.Println("closing neo4j conn")
 		manager.Stop()
 	})
+	err = neo4j.InitSchema(manager)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
 	a.initNatsPublisher(natsConn)
+	a.initEventPublisher()
+	a.initDecisionLogger()
 	a.initAdministrationNatsSubscriber(natsConn)
+	a.initEvaluationNatsSubscriber(natsConn)
 
 	a.initRhabacNeo4jRepo(manager)
 
-	a.initAdministratorService()
 	a.initEvaluatorService()
+	a.initAdministratorService()
+	a.initOutboxRelay()
+
+	a.initAuthenticator()
 
 	a.initAdministratorAsyncServer()
+	a.initEvaluatorAsyncServer()
 	a.initAdministratorGrpcServer()
 	a.initEvaluatorGrpcServer()
 	a.initGrpcServer()
@@ -127,7 +158,22 @@ func (a *app) initGrpcServer() {
 	if a.evaluatorGrpcServer == nil {
 		log.Fatalln("eval grpc server is nil")
 	}
-	s := grpc.NewServer()
+	options := make([]grpc.ServerOption, 0)
+	if a.config.Server().TLS().Enabled() {
+		creds, err := newGrpcServerCredentials(a.config.Server().TLS())
+		if err != nil {
+			log.Fatalln(err)
+		}
+		options = append(options, grpc.Creds(creds))
+	}
+	if a.authenticator != nil {
+		options = append(options,
+			grpc.UnaryInterceptor(a.authenticator.UnaryInterceptor),
+			grpc.StreamInterceptor(a.authenticator.StreamInterceptor))
+	} else {
+		options = append(options, grpc.UnaryInterceptor(servers.ActorUnaryInterceptor))
+	}
+	s := grpc.NewServer(options...)
 	api.RegisterOortAdministratorServer(s, a.administratorGrpcServer)
 	api.RegisterOortEvaluatorServer(s, a.evaluatorGrpcServer)
 	reflection.Register(s)
@@ -166,18 +212,36 @@ func (a *app) initAdministratorAsyncServer() {
 	if a.administratorSubscriber == nil {
 		log.Fatalln("administration subscriber is nil")
 	}
-	server, err := servers.NewAdministratorAsyncServer(a.administratorSubscriber, a.publisher, *a.administrationService)
+	server, err := servers.NewAdministratorAsyncServer(a.administratorSubscriber, a.publisher, *a.administrationService,
+		a.config.Nats().JetStream().Enabled(), a.authenticator)
 	if err != nil {
 		log.Fatalln(err)
 	}
 	a.administratorAsyncServer = server
 }
 
+func (a *app) initEvaluatorAsyncServer() {
+	if a.evaluationService == nil {
+		log.Fatalln("eval service is nil")
+	}
+	if a.publisher == nil {
+		log.Fatalln("publisher is nil")
+	}
+	if a.evaluatorSubscriber == nil {
+		log.Fatalln("evaluation subscriber is nil")
+	}
+	server, err := servers.NewEvaluatorAsyncServer(a.evaluatorSubscriber, a.publisher, *a.evaluationService)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.evaluatorAsyncServer = server
+}
+
 func (a *app) initEvaluatorService() {
 	if a.rhabacRepo == nil {
 		log.Fatalln("rhabac repo is nil")
 	}
-	evaluatorService, err := services.NewEvaluationService(a.rhabacRepo)
+	evaluatorService, err := services.NewEvaluationService(a.rhabacRepo, a.decisionLogger)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -188,13 +252,36 @@ func (a *app) initAdministratorService() {
 	if a.rhabacRepo == nil {
 		log.Fatalln("rhabac repo is nil")
 	}
-	administratorService, err := services.NewAdministrationService(a.rhabacRepo)
+	var authorizer *services.AdministrationAuthorizer
+	if a.config.Auth().Enabled() {
+		if a.evaluationService == nil {
+			log.Fatalln("eval service is nil")
+		}
+		var err error
+		authorizer, err = services.NewAdministrationAuthorizer(a.rhabacRepo, *a.evaluationService, a.config.Auth().Superusers())
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+	administratorService, err := services.NewAdministrationService(a.rhabacRepo, authorizer)
 	if err != nil {
 		log.Fatalln(err)
 	}
 	a.administrationService = administratorService
 }
 
+// initAuthenticator leaves administration callers unauthenticated unless auth is enabled
+func (a *app) initAuthenticator() {
+	if !a.config.Auth().Enabled() {
+		return
+	}
+	authenticator, err := servers.NewAuthenticator(a.config.Auth().TokensFile())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.authenticator = authenticator
+}
+
 func (a *app) initNatsPublisher(conn *natsgo.Conn) {
 	publisher, err := nats.NewPublisher(conn)
 	if err != nil {
@@ -203,7 +290,83 @@ func (a *app) initNatsPublisher(conn *natsgo.Conn) {
 	a.publisher = publisher
 }
 
+func (a *app) initEventPublisher() {
+	if a.publisher == nil {
+		log.Fatalln("publisher is nil")
+	}
+	eventPublisher, err := events.NewNatsEventPublisher(a.publisher)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.eventPublisher = eventPublisher
+}
+
+// initDecisionLogger combines the configured decision log sinks, decisions aren't logged if there are none
+func (a *app) initDecisionLogger() {
+	config := a.config.Decisions()
+	loggers := make([]domain.DecisionLogger, 0)
+	if config.NatsEnabled() {
+		if a.publisher == nil {
+			log.Fatalln("publisher is nil")
+		}
+		natsLogger, err := decisions.NewNatsDecisionLogger(a.publisher)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		loggers = append(loggers, natsLogger)
+	}
+	if config.FilePath() != "" {
+		fileLogger, err := decisions.NewFileDecisionLogger(config.FilePath(), config.FileMaxSize(), config.FileMaxBackups())
+		if err != nil {
+			log.Fatalln(err)
+		}
+		a.shutdownProcesses = append(a.shutdownProcesses, func() {
+			log.Println("closing decision log file")
+			if closer, ok := fileLogger.(io.Closer); ok {
+				_ = closer.Close()
+			}
+		})
+		loggers = append(loggers, fileLogger)
+	}
+	if len(loggers) == 0 {
+		return
+	}
+	decisionLogger, err := decisions.NewSamplingDecisionLogger(decisions.NewMultiDecisionLogger(loggers...), config.SampleRate())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.decisionLogger = decisionLogger
+}
+
+func (a *app) initOutboxRelay() {
+	if a.rhabacRepo == nil {
+		log.Fatalln("rhabac repo is nil")
+	}
+	if a.eventPublisher == nil {
+		log.Fatalln("event publisher is nil")
+	}
+	a.outboxRelay = newOutboxRelay(a.rhabacRepo, a.eventPublisher)
+}
+
 func (a *app) initAdministrationNatsSubscriber(conn *natsgo.Conn) {
+	jsConfig := a.config.Nats().JetStream()
+	if jsConfig.Enabled() {
+		administrationSubscriber, err := nats.NewJetStreamSubscriber(conn, api.AdministrationReqSubject, "oort", nats.JetStreamConfig{
+			Stream:            jsConfig.Stream(),
+			Durable:           jsConfig.Durable(),
+			MaxDeliver:        jsConfig.MaxDeliver(),
+			BackOff:           jsConfig.BackOff(),
+			DeadLetterSubject: jsConfig.DeadLetterSubject(),
+			DeadLetterHeaders: []string{api.ActorHeader},
+			AckWait:           jsConfig.AckWait(),
+			MaxAge:            jsConfig.MaxAge(),
+		})
+		if err != nil {
+			log.Fatalln(err)
+		}
+		a.administratorSubscriber = administrationSubscriber
+		return
+	}
 	administrationSubscriber, err := nats.NewSubscriber(conn, api.AdministrationReqSubject, "oort")
 	if err != nil {
 		log.Fatalln(err)
@@ -211,10 +374,27 @@ func (a *app) initAdministrationNatsSubscriber(conn *natsgo.Conn) {
 	a.administratorSubscriber = administrationSubscriber
 }
 
+func (a *app) initEvaluationNatsSubscriber(conn *natsgo.Conn) {
+	evaluationSubscriber, err := nats.NewSubscriber(conn, api.AuthorizationReqSubject, "oort")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.evaluatorSubscriber = evaluationSubscriber
+}
+
 func (a *app) initRhabacNeo4jRepo(manager *neo4j.TransactionManager) {
 	a.rhabacRepo = neo4j.NewRHABACRepo(manager, neo4j.NewSimpleCypherFactory())
 }
 
+func (a *app) startOutboxRelay() {
+	a.outboxRelay.Start()
+	a.gracefulShutdownProcesses = append(a.gracefulShutdownProcesses, func(wg *sync.WaitGroup) {
+		a.outboxRelay.GracefulStop()
+		log.Println("outbox relay gracefully stopped")
+		wg.Done()
+	})
+}
+
 func (a *app) startAdministratorAsyncServer() error {
 	err := a.administratorAsyncServer.Serve()
 	if err != nil {
@@ -228,6 +408,19 @@ func (a *app) startAdministratorAsyncServer() error {
 	return nil
 }
 
+func (a *app) startEvaluatorAsyncServer() error {
+	err := a.evaluatorAsyncServer.Serve()
+	if err != nil {
+		return err
+	}
+	a.gracefulShutdownProcesses = append(a.gracefulShutdownProcesses, func(wg *sync.WaitGroup) {
+		a.evaluatorAsyncServer.GracefulStop()
+		log.Println("evaluation async server gracefully stopped")
+		wg.Done()
+	})
+	return nil
+}
+
 func (a *app) startGrpcServer() error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", a.config.Server().Port()))
 	if err != nil {
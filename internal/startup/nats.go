@@ -1,9 +1,31 @@
 package startup
 
-import "github.com/nats-io/nats.go"
+import (
+	natsconfig "github.com/c12s/oort/internal/configs/nats"
+	"github.com/nats-io/nats.go"
+)
 
-func newNatsConn(uri string) (*nats.Conn, error) {
-	connection, err := nats.Connect(uri)
+func newNatsConn(config natsconfig.Config) (*nats.Conn, error) {
+	options := make([]nats.Option, 0)
+	if config.TLS().Enabled() {
+		options = append(options, nats.Secure())
+		if config.TLS().CAFile() != "" {
+			options = append(options, nats.RootCAs(config.TLS().CAFile()))
+		}
+		if config.TLS().CertFile() != "" {
+			options = append(options, nats.ClientCert(config.TLS().CertFile(), config.TLS().KeyFile()))
+		}
+	}
+	if config.CredsFile() != "" {
+		options = append(options, nats.UserCredentials(config.CredsFile()))
+	} else if config.NkeySeedFile() != "" {
+		option, err := nats.NkeyOptionFromSeed(config.NkeySeedFile())
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	connection, err := nats.Connect(config.Uri(), options...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+package startup
+
+import (
+	neo4jconfig "github.com/c12s/oort/internal/configs/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// newNeo4jConfigurers customizes the driver with the settings that aren't part of the uri
+func newNeo4jConfigurers(config neo4jconfig.Config) ([]func(*neo4j.Config), error) {
+	configurers := make([]func(*neo4j.Config), 0)
+	if config.TLS().Enabled() && config.TLS().CAFile() != "" {
+		rootCAs, err := newCertPool(config.TLS().CAFile())
+		if err != nil {
+			return nil, err
+		}
+		configurers = append(configurers, func(c *neo4j.Config) {
+			c.RootCAs = rootCAs
+		})
+	}
+	configurers = append(configurers, func(c *neo4j.Config) {
+		if config.MaxConnectionPoolSize() > 0 {
+			c.MaxConnectionPoolSize = config.MaxConnectionPoolSize()
+		}
+		if config.ConnectionAcquisitionTimeout() > 0 {
+			c.ConnectionAcquisitionTimeout = config.ConnectionAcquisitionTimeout()
+		}
+		if config.MaxTransactionRetryTime() > 0 {
+			c.MaxTransactionRetryTime = config.MaxTransactionRetryTime()
+		}
+	})
+	return configurers, nil
+}
+
+func newNeo4jAuth(config neo4jconfig.Config) neo4j.AuthToken {
+	switch config.AuthScheme() {
+	case neo4jconfig.AuthSchemeBasic:
+		return neo4j.BasicAuth(config.Username(), config.Password(), "")
+	case neo4jconfig.AuthSchemeBearer:
+		return neo4j.BearerAuth(config.BearerToken())
+	default:
+		return neo4j.NoAuth()
+	}
+}
@@ -0,0 +1,101 @@
+package startup
+
+import (
+	"log"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+const (
+	outboxRelayInterval  = time.Second
+	outboxRelayBatchSize = 100
+	// delivered events are kept for a while so that consumers can replay them
+	outboxRetention = 24 * time.Hour
+)
+
+// outboxRelay publishes change events written to the outbox and marks them delivered.
+// An event can be published more than once if marking fails, consumers deduplicate by event id.
+type outboxRelay struct {
+	repo      domain.RHABACRepo
+	publisher domain.EventPublisher
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func newOutboxRelay(repo domain.RHABACRepo, publisher domain.EventPublisher) *outboxRelay {
+	return &outboxRelay{
+		repo:      repo,
+		publisher: publisher,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (r *outboxRelay) Start() {
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(outboxRelayInterval)
+		defer ticker.Stop()
+		lastPrune := time.Time{}
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				r.relay()
+				if time.Since(lastPrune) > outboxRetention/24 {
+					r.prune()
+					lastPrune = time.Now()
+				}
+			}
+		}
+	}()
+}
+
+func (r *outboxRelay) GracefulStop() {
+	close(r.stop)
+	<-r.done
+}
+
+// relay publishes undelivered events in revision order until the outbox is drained,
+// it stops at the first failure so that events aren't published out of order
+func (r *outboxRelay) relay() {
+	for {
+		resp := r.repo.GetEvents(domain.GetEventsReq{
+			UndeliveredOnly: true,
+			Limit:           outboxRelayBatchSize,
+		})
+		if resp.Error != nil {
+			log.Println(resp.Error)
+			return
+		}
+		published := make([]string, 0, len(resp.Events))
+		var err error
+		for _, event := range resp.Events {
+			err = r.publisher.Publish(event)
+			if err != nil {
+				log.Println(err)
+				break
+			}
+			published = append(published, event.Id)
+		}
+		if len(published) > 0 {
+			markResp := r.repo.MarkEventsDelivered(domain.MarkEventsDeliveredReq{Ids: published})
+			if markResp.Error != nil {
+				log.Println(markResp.Error)
+				return
+			}
+		}
+		if err != nil || len(resp.Events) < outboxRelayBatchSize {
+			return
+		}
+	}
+}
+
+func (r *outboxRelay) prune() {
+	resp := r.repo.PruneEvents(domain.PruneEventsReq{DeliveredBefore: time.Now().Add(-outboxRetention)})
+	if resp.Error != nil {
+		log.Println(resp.Error)
+	}
+}
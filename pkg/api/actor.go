@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+
+	"github.com/c12s/oort/pkg/messaging"
+)
+
+const (
+	// ActorHeader carries the identity of the caller of async administration requests
+	ActorHeader = "Oort-Actor"
+	// ActorMetadataKey carries the identity of gRPC callers that don't present a client certificate
+	ActorMetadataKey = "oort-actor"
+	// AuthorizationHeader carries the bearer token of async administration requests when
+	// callers are authenticated, gRPC callers use the authorization metadata instead
+	AuthorizationHeader = "Oort-Authorization"
+)
+
+type actorKey struct{}
+type tokenKey struct{}
+
+// WithActor returns a context whose async administration requests are sent on behalf of the actor
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// WithToken returns a context whose async administration requests are authenticated with the bearer token
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+func requestHeader(ctx context.Context) messaging.Header {
+	header := messaging.Header{}
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		header[ActorHeader] = actor
+	}
+	if token, ok := ctx.Value(tokenKey{}).(string); ok && token != "" {
+		header[AuthorizationHeader] = "Bearer " + token
+	}
+	return header
+}
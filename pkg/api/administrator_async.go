@@ -1,74 +1,134 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"time"
+
 	"github.com/c12s/oort/pkg/messaging"
 	"github.com/c12s/oort/pkg/messaging/nats"
 	natsgo "github.com/nats-io/nats.go"
-	"log"
+	"github.com/nats-io/nuid"
 )
 
+// DefaultAdministrationTimeout is applied to callback requests whose context has no deadline
+const DefaultAdministrationTimeout = 30 * time.Second
+
 type AdministrationAsyncClient struct {
-	publisher         messaging.Publisher
-	subscriberFactory func(subject string) messaging.Subscriber
+	conn       *natsgo.Conn
+	publisher  messaging.Publisher
+	subscriber messaging.Subscriber
+	// all responses are received on this subject and matched to requests by correlation id
+	replySubject string
+	pending      *pendingReplies[*AdministrationAsyncResp]
 }
 
-func NewAdministrationAsyncClient(natsAddress string) (*AdministrationAsyncClient, error) {
-	conn, err := natsgo.Connect(fmt.Sprintf("nats://%s", natsAddress))
+// NewAdministrationAsyncClient connects to NATS with the options, such as natsgo.Secure,
+// natsgo.RootCAs or natsgo.UserCredentials when the server requires TLS or credentials
+func NewAdministrationAsyncClient(natsAddress string, options ...natsgo.Option) (*AdministrationAsyncClient, error) {
+	conn, err := natsgo.Connect(fmt.Sprintf("nats://%s", natsAddress), options...)
 	if err != nil {
 		return nil, err
 	}
 	publisher, err := nats.NewPublisher(conn)
 	if err != nil {
+		conn.Close()
 		return nil, err
 	}
-	subscriberFactory := func(subject string) messaging.Subscriber {
-		subscriber, _ := nats.NewSubscriber(conn, subject, "")
-		return subscriber
+	replySubject := publisher.GenerateReplySubject()
+	subscriber, err := nats.NewSubscriber(conn, replySubject, "")
+	if err != nil {
+		conn.Close()
+		return nil, err
 	}
-	return &AdministrationAsyncClient{
-		publisher:         publisher,
-		subscriberFactory: subscriberFactory,
-	}, nil
+	client := &AdministrationAsyncClient{
+		conn:         conn,
+		publisher:    publisher,
+		subscriber:   subscriber,
+		replySubject: replySubject,
+		pending:      newPendingReplies[*AdministrationAsyncResp](),
+	}
+	err = subscriber.Subscribe(client.receive)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
 }
 
-func (n *AdministrationAsyncClient) SendRequest(req AdministrationReq, callback AdministrationCallback) error {
-	reqMarshalled, err := req.Marshal()
+// Request sends the request and waits for the response until the context is done.
+// The request is sent on behalf of the actor and with the token set with WithActor and WithToken, if any.
+func (n *AdministrationAsyncClient) Request(ctx context.Context, req AdministrationReq) (*AdministrationAsyncResp, error) {
+	correlationId, respCh, err := n.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return n.pending.wait(ctx, correlationId, respCh)
+}
+
+// SendRequest sends the request and passes the response to the callback. If no response arrives
+// before the context's deadline, or DefaultAdministrationTimeout if it has none, the callback
+// receives ErrRequestTimeout. An error is returned only if the request couldn't be sent.
+func (n *AdministrationAsyncClient) SendRequest(ctx context.Context, req AdministrationReq, callback AdministrationCallback) error {
+	correlationId, respCh, err := n.send(ctx, req)
 	if err != nil {
 		return err
 	}
+	var cancel context.CancelFunc
+	if _, ok := ctx.Deadline(); ok {
+		ctx, cancel = context.WithCancel(ctx)
+	} else {
+		ctx, cancel = context.WithTimeout(ctx, DefaultAdministrationTimeout)
+	}
+	go func() {
+		defer cancel()
+		callback(n.pending.wait(ctx, correlationId, respCh))
+	}()
+	return nil
+}
+
+// Close stops receiving responses and closes the connection
+func (n *AdministrationAsyncClient) Close() error {
+	err := n.subscriber.Unsubscribe()
+	n.conn.Close()
+	return err
+}
+
+func (n *AdministrationAsyncClient) send(ctx context.Context, req AdministrationReq) (string, chan *AdministrationAsyncResp, error) {
+	reqMarshalled, err := req.Marshal()
+	if err != nil {
+		return "", nil, err
+	}
 	adminReq := &AdministrationAsyncReq{
 		Kind:          req.Kind(),
 		ReqMarshalled: reqMarshalled,
+		CorrelationId: nuid.Next(),
 	}
 	adminReqMarshalled, err := adminReq.Marshal()
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
-	// handle responses
-	replySubject := n.publisher.GenerateReplySubject()
-	subscriber := n.subscriberFactory(replySubject)
-	err = subscriber.Subscribe(func(msg []byte, _ string) {
-		resp := &AdministrationAsyncResp{}
-		err := resp.Unmarshal(msg)
-		if err != nil {
-			log.Println(err)
-			return
-		}
-		callback(resp)
-	})
+	respCh := n.pending.register(adminReq.CorrelationId)
+	err = n.publisher.Request(adminReqMarshalled, AdministrationReqSubject, n.replySubject, requestHeader(ctx))
 	if err != nil {
-		return err
+		n.pending.forget(adminReq.CorrelationId)
+		return "", nil, err
 	}
+	return adminReq.CorrelationId, respCh, nil
+}
 
-	// send request
-	err = n.publisher.Request(adminReqMarshalled, AdministrationReqSubject, replySubject)
+func (n *AdministrationAsyncClient) receive(msg []byte, _ string, _ messaging.Header) error {
+	resp := &AdministrationAsyncResp{}
+	err := resp.Unmarshal(msg)
 	if err != nil {
-		_ = subscriber.Unsubscribe()
 		return err
 	}
+	if !n.pending.deliver(resp.CorrelationId, resp) {
+		log.Printf("no pending request with correlation id %q", resp.CorrelationId)
+	}
 	return nil
 }
 
-type AdministrationCallback func(resp *AdministrationAsyncResp)
+type AdministrationCallback func(resp *AdministrationAsyncResp, err error)
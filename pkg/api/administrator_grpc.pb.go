@@ -30,6 +30,15 @@ type OortAdministratorClient interface {
 	DeleteAttribute(ctx context.Context, in *DeleteAttributeReq, opts ...grpc.CallOption) (*AdministrationResp, error)
 	CreatePolicy(ctx context.Context, in *CreatePolicyReq, opts ...grpc.CallOption) (*AdministrationResp, error)
 	DeletePolicy(ctx context.Context, in *DeletePolicyReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	ApplyBatch(ctx context.Context, in *ApplyBatchReq, opts ...grpc.CallOption) (*ApplyBatchResp, error)
+	GetResource(ctx context.Context, in *GetResourceReq, opts ...grpc.CallOption) (*GetResourceResp, error)
+	ListResources(ctx context.Context, in *ListResourcesReq, opts ...grpc.CallOption) (*ListResourcesResp, error)
+	ListChildren(ctx context.Context, in *ListChildrenReq, opts ...grpc.CallOption) (*ListRelatedResourcesResp, error)
+	ListAncestors(ctx context.Context, in *ListAncestorsReq, opts ...grpc.CallOption) (*ListRelatedResourcesResp, error)
+	ListPolicies(ctx context.Context, in *ListPoliciesReq, opts ...grpc.CallOption) (*ListPoliciesResp, error)
+	GetPoliciesForResource(ctx context.Context, in *GetPoliciesForResourceReq, opts ...grpc.CallOption) (*GetPoliciesForResourceResp, error)
+	Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (OortAdministrator_WatchClient, error)
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsReq, opts ...grpc.CallOption) (*ListAuditRecordsResp, error)
 }
 
 type oortAdministratorClient struct {
@@ -112,6 +121,110 @@ func (c *oortAdministratorClient) DeletePolicy(ctx context.Context, in *DeletePo
 	return out, nil
 }
 
+func (c *oortAdministratorClient) ApplyBatch(ctx context.Context, in *ApplyBatchReq, opts ...grpc.CallOption) (*ApplyBatchResp, error) {
+	out := new(ApplyBatchResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/ApplyBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) GetResource(ctx context.Context, in *GetResourceReq, opts ...grpc.CallOption) (*GetResourceResp, error) {
+	out := new(GetResourceResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/GetResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) ListResources(ctx context.Context, in *ListResourcesReq, opts ...grpc.CallOption) (*ListResourcesResp, error) {
+	out := new(ListResourcesResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) ListChildren(ctx context.Context, in *ListChildrenReq, opts ...grpc.CallOption) (*ListRelatedResourcesResp, error) {
+	out := new(ListRelatedResourcesResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/ListChildren", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) ListAncestors(ctx context.Context, in *ListAncestorsReq, opts ...grpc.CallOption) (*ListRelatedResourcesResp, error) {
+	out := new(ListRelatedResourcesResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/ListAncestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) ListPolicies(ctx context.Context, in *ListPoliciesReq, opts ...grpc.CallOption) (*ListPoliciesResp, error) {
+	out := new(ListPoliciesResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/ListPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) GetPoliciesForResource(ctx context.Context, in *GetPoliciesForResourceReq, opts ...grpc.CallOption) (*GetPoliciesForResourceResp, error) {
+	out := new(GetPoliciesForResourceResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/GetPoliciesForResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (OortAdministrator_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OortAdministrator_ServiceDesc.Streams[0], "/proto.OortAdministrator/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oortAdministratorWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OortAdministrator_WatchClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type oortAdministratorWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *oortAdministratorWatchClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oortAdministratorClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsReq, opts ...grpc.CallOption) (*ListAuditRecordsResp, error) {
+	out := new(ListAuditRecordsResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/ListAuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OortAdministratorServer is the server API for OortAdministrator service.
 // All implementations must embed UnimplementedOortAdministratorServer
 // for forward compatibility
@@ -124,6 +237,15 @@ type OortAdministratorServer interface {
 	DeleteAttribute(context.Context, *DeleteAttributeReq) (*AdministrationResp, error)
 	CreatePolicy(context.Context, *CreatePolicyReq) (*AdministrationResp, error)
 	DeletePolicy(context.Context, *DeletePolicyReq) (*AdministrationResp, error)
+	ApplyBatch(context.Context, *ApplyBatchReq) (*ApplyBatchResp, error)
+	GetResource(context.Context, *GetResourceReq) (*GetResourceResp, error)
+	ListResources(context.Context, *ListResourcesReq) (*ListResourcesResp, error)
+	ListChildren(context.Context, *ListChildrenReq) (*ListRelatedResourcesResp, error)
+	ListAncestors(context.Context, *ListAncestorsReq) (*ListRelatedResourcesResp, error)
+	ListPolicies(context.Context, *ListPoliciesReq) (*ListPoliciesResp, error)
+	GetPoliciesForResource(context.Context, *GetPoliciesForResourceReq) (*GetPoliciesForResourceResp, error)
+	Watch(*WatchReq, OortAdministrator_WatchServer) error
+	ListAuditRecords(context.Context, *ListAuditRecordsReq) (*ListAuditRecordsResp, error)
 	mustEmbedUnimplementedOortAdministratorServer()
 }
 
@@ -155,6 +277,33 @@ func (UnimplementedOortAdministratorServer) CreatePolicy(context.Context, *Creat
 func (UnimplementedOortAdministratorServer) DeletePolicy(context.Context, *DeletePolicyReq) (*AdministrationResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
 }
+func (UnimplementedOortAdministratorServer) ApplyBatch(context.Context, *ApplyBatchReq) (*ApplyBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBatch not implemented")
+}
+func (UnimplementedOortAdministratorServer) GetResource(context.Context, *GetResourceReq) (*GetResourceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
+}
+func (UnimplementedOortAdministratorServer) ListResources(context.Context, *ListResourcesReq) (*ListResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedOortAdministratorServer) ListChildren(context.Context, *ListChildrenReq) (*ListRelatedResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildren not implemented")
+}
+func (UnimplementedOortAdministratorServer) ListAncestors(context.Context, *ListAncestorsReq) (*ListRelatedResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAncestors not implemented")
+}
+func (UnimplementedOortAdministratorServer) ListPolicies(context.Context, *ListPoliciesReq) (*ListPoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
+}
+func (UnimplementedOortAdministratorServer) GetPoliciesForResource(context.Context, *GetPoliciesForResourceReq) (*GetPoliciesForResourceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoliciesForResource not implemented")
+}
+func (UnimplementedOortAdministratorServer) Watch(*WatchReq, OortAdministrator_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedOortAdministratorServer) ListAuditRecords(context.Context, *ListAuditRecordsReq) (*ListAuditRecordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
 func (UnimplementedOortAdministratorServer) mustEmbedUnimplementedOortAdministratorServer() {}
 
 // UnsafeOortAdministratorServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +461,171 @@ func _OortAdministrator_DeletePolicy_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OortAdministrator_ApplyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).ApplyBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/ApplyBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).ApplyBatch(ctx, req.(*ApplyBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).GetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/GetResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).GetResource(ctx, req.(*GetResourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).ListResources(ctx, req.(*ListResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/ListChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).ListChildren(ctx, req.(*ListChildrenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_ListAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAncestorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).ListAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/ListAncestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).ListAncestors(ctx, req.(*ListAncestorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).ListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/ListPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).ListPolicies(ctx, req.(*ListPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_GetPoliciesForResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoliciesForResourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).GetPoliciesForResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/GetPoliciesForResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).GetPoliciesForResource(ctx, req.(*GetPoliciesForResourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OortAdministratorServer).Watch(m, &oortAdministratorWatchServer{stream})
+}
+
+type OortAdministrator_WatchServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type oortAdministratorWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *oortAdministratorWatchServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OortAdministrator_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/ListAuditRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).ListAuditRecords(ctx, req.(*ListAuditRecordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OortAdministrator_ServiceDesc is the grpc.ServiceDesc for OortAdministrator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,7 +665,45 @@ var OortAdministrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePolicy",
 			Handler:    _OortAdministrator_DeletePolicy_Handler,
 		},
+		{
+			MethodName: "ApplyBatch",
+			Handler:    _OortAdministrator_ApplyBatch_Handler,
+		},
+		{
+			MethodName: "GetResource",
+			Handler:    _OortAdministrator_GetResource_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _OortAdministrator_ListResources_Handler,
+		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _OortAdministrator_ListChildren_Handler,
+		},
+		{
+			MethodName: "ListAncestors",
+			Handler:    _OortAdministrator_ListAncestors_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _OortAdministrator_ListPolicies_Handler,
+		},
+		{
+			MethodName: "GetPoliciesForResource",
+			Handler:    _OortAdministrator_GetPoliciesForResource_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _OortAdministrator_ListAuditRecords_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _OortAdministrator_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "administrator.proto",
 }
@@ -106,6 +106,18 @@ func (x *DeletePolicyReq) Kind() AdministrationAsyncReq_ReqKind {
 	return AdministrationAsyncReq_DeletePolicy
 }
 
+func (x *ApplyBatchReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *ApplyBatchReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *ApplyBatchReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_ApplyBatch
+}
+
 func (x *AdministrationAsyncReq) Marshal() ([]byte, error) {
 	return proto.Marshal(x)
 }
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrRequestTimeout = errors.New("request timed out")
+
+// AsyncError is an error reported in a response to an async request
+type AsyncError struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (e *AsyncError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// pendingReplies matches responses received on a shared reply subject to requests by correlation id
+type pendingReplies[T any] struct {
+	replies map[string]chan T
+	lock    sync.Mutex
+}
+
+func newPendingReplies[T any]() *pendingReplies[T] {
+	return &pendingReplies[T]{
+		replies: make(map[string]chan T),
+	}
+}
+
+// register must be called before the request is sent, so that the response can't arrive first
+func (p *pendingReplies[T]) register(correlationId string) chan T {
+	replyCh := make(chan T, 1)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.replies[correlationId] = replyCh
+	return replyCh
+}
+
+func (p *pendingReplies[T]) forget(correlationId string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.replies, correlationId)
+}
+
+// deliver reports whether a request was waiting for the response
+func (p *pendingReplies[T]) deliver(correlationId string, resp T) bool {
+	p.lock.Lock()
+	replyCh, ok := p.replies[correlationId]
+	delete(p.replies, correlationId)
+	p.lock.Unlock()
+	if ok {
+		replyCh <- resp
+	}
+	return ok
+}
+
+func (p *pendingReplies[T]) wait(ctx context.Context, correlationId string, replyCh chan T) (T, error) {
+	defer p.forget(correlationId)
+	select {
+	case resp := <-replyCh:
+		return resp, nil
+	case <-ctx.Done():
+		var empty T
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return empty, ErrRequestTimeout
+		}
+		return empty, ctx.Err()
+	}
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPendingRepliesDeliver(t *testing.T) {
+	pending := newPendingReplies[string]()
+	replyCh := pending.register("1")
+	if !pending.deliver("1", "resp") {
+		t.Fatal("expected the reply to be delivered")
+	}
+	resp, err := pending.wait(context.Background(), "1", replyCh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp != "resp" {
+		t.Errorf("expected resp, got %q", resp)
+	}
+}
+
+func TestPendingRepliesTimeout(t *testing.T) {
+	pending := newPendingReplies[string]()
+	replyCh := pending.register("1")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := pending.wait(ctx, "1", replyCh)
+	if !errors.Is(err, ErrRequestTimeout) {
+		t.Errorf("expected %v, got %v", ErrRequestTimeout, err)
+	}
+	if pending.deliver("1", "late") {
+		t.Error("expected a reply arriving after the timeout to be dropped")
+	}
+	if len(pending.replies) != 0 {
+		t.Errorf("expected no pending replies, got %d", len(pending.replies))
+	}
+}
+
+func TestPendingRepliesCanceled(t *testing.T) {
+	pending := newPendingReplies[string]()
+	replyCh := pending.register("1")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := pending.wait(ctx, "1", replyCh)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestPendingRepliesUnknownCorrelationId(t *testing.T) {
+	pending := newPendingReplies[string]()
+	pending.register("1")
+	if pending.deliver("2", "resp") {
+		t.Error("expected a reply to an unknown request to be dropped")
+	}
+	if len(pending.replies) != 1 {
+		t.Errorf("expected the registered request to keep waiting, got %d pending", len(pending.replies))
+	}
+}
+
+func TestAdministrationAsyncClientDropsLateReply(t *testing.T) {
+	client := &AdministrationAsyncClient{pending: newPendingReplies[*AdministrationAsyncResp]()}
+	replyCh := client.pending.register("1")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.pending.wait(ctx, "1", replyCh); !errors.Is(err, ErrRequestTimeout) {
+		t.Fatalf("expected %v, got %v", ErrRequestTimeout, err)
+	}
+
+	msg, err := (&AdministrationAsyncResp{CorrelationId: "1"}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.receive(msg, "", nil); err != nil {
+		t.Errorf("expected the late reply to be dropped without an error, got %v", err)
+	}
+	select {
+	case resp := <-replyCh:
+		t.Errorf("expected no reply after the timeout, got %v", resp)
+	default:
+	}
+}
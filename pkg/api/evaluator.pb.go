@@ -20,34 +20,658 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EvalResult int32
+
+const (
+	EvalResult_ALLOWED        EvalResult = 0
+	EvalResult_DENIED         EvalResult = 1
+	EvalResult_NON_EVALUATIVE EvalResult = 2
+)
+
+// Enum value maps for EvalResult.
+var (
+	EvalResult_name = map[int32]string{
+		0: "ALLOWED",
+		1: "DENIED",
+		2: "NON_EVALUATIVE",
+	}
+	EvalResult_value = map[string]int32{
+		"ALLOWED":        0,
+		"DENIED":         1,
+		"NON_EVALUATIVE": 2,
+	}
+)
+
+func (x EvalResult) Enum() *EvalResult {
+	p := new(EvalResult)
+	*p = x
+	return p
+}
+
+func (x EvalResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvalResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_evaluator_proto_enumTypes[0].Descriptor()
+}
+
+func (EvalResult) Type() protoreflect.EnumType {
+	return &file_evaluator_proto_enumTypes[0]
+}
+
+func (x EvalResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvalResult.Descriptor instead.
+func (EvalResult) EnumDescriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{0}
+}
+
 type AuthorizationReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subject        *Resource    `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Object         *Resource    `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
-	EnvAttributes  []*Attribute `protobuf:"bytes,3,rep,name=envAttributes,proto3" json:"envAttributes,omitempty"`
-	PermissionName string       `protobuf:"bytes,4,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	Subject        *Resource    `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Object         *Resource    `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	EnvAttributes  []*Attribute `protobuf:"bytes,3,rep,name=envAttributes,proto3" json:"envAttributes,omitempty"`
+	PermissionName string       `protobuf:"bytes,4,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	Explain        bool         `protobuf:"varint,5,opt,name=explain,proto3" json:"explain,omitempty"`
+}
+
+func (x *AuthorizationReq) Reset() {
+	*x = AuthorizationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationReq) ProtoMessage() {}
+
+func (x *AuthorizationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationReq.ProtoReflect.Descriptor instead.
+func (*AuthorizationReq) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthorizationReq) GetSubject() *Resource {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *AuthorizationReq) GetObject() *Resource {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *AuthorizationReq) GetEnvAttributes() []*Attribute {
+	if x != nil {
+		return x.EnvAttributes
+	}
+	return nil
+}
+
+func (x *AuthorizationReq) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *AuthorizationReq) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+type AuthorizationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authorized  bool         `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Explanation *Explanation `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *AuthorizationResp) Reset() {
+	*x = AuthorizationResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationResp) ProtoMessage() {}
+
+func (x *AuthorizationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationResp.ProtoReflect.Descriptor instead.
+func (*AuthorizationResp) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthorizationResp) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+func (x *AuthorizationResp) GetExplanation() *Explanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type AuthorizationBatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// at most 100 checks
+	Checks []*AuthorizationReq `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *AuthorizationBatchReq) Reset() {
+	*x = AuthorizationBatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationBatchReq) ProtoMessage() {}
+
+func (x *AuthorizationBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationBatchReq.ProtoReflect.Descriptor instead.
+func (*AuthorizationBatchReq) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthorizationBatchReq) GetChecks() []*AuthorizationReq {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type AuthorizationBatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are in the same order as the checks in the request
+	Results []*AuthorizationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *AuthorizationBatchResp) Reset() {
+	*x = AuthorizationBatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationBatchResp) ProtoMessage() {}
+
+func (x *AuthorizationBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationBatchResp.ProtoReflect.Descriptor instead.
+func (*AuthorizationBatchResp) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthorizationBatchResp) GetResults() []*AuthorizationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AuthorizationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authorized  bool         `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Explanation *Explanation `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	ErrorCode   ErrorCode    `protobuf:"varint,3,opt,name=errorCode,proto3,enum=proto.ErrorCode" json:"errorCode,omitempty"`
+	Error       string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AuthorizationResult) Reset() {
+	*x = AuthorizationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationResult) ProtoMessage() {}
+
+func (x *AuthorizationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationResult.ProtoReflect.Descriptor instead.
+func (*AuthorizationResult) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthorizationResult) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+func (x *AuthorizationResult) GetExplanation() *Explanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+func (x *AuthorizationResult) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_OK
+}
+
+func (x *AuthorizationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Explanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Levels []*PermissionLevelExplanation `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	// index of the level that produced the result, -1 if the result is the default one
+	DecidingLevel int32      `protobuf:"varint,2,opt,name=decidingLevel,proto3" json:"decidingLevel,omitempty"`
+	DefaultResult bool       `protobuf:"varint,3,opt,name=defaultResult,proto3" json:"defaultResult,omitempty"`
+	Result        EvalResult `protobuf:"varint,4,opt,name=result,proto3,enum=proto.EvalResult" json:"result,omitempty"`
+}
+
+func (x *Explanation) Reset() {
+	*x = Explanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Explanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation) ProtoMessage() {}
+
+func (x *Explanation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation.ProtoReflect.Descriptor instead.
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Explanation) GetLevels() []*PermissionLevelExplanation {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *Explanation) GetDecidingLevel() int32 {
+	if x != nil {
+		return x.DecidingLevel
+	}
+	return 0
+}
+
+func (x *Explanation) GetDefaultResult() bool {
+	if x != nil {
+		return x.DefaultResult
+	}
+	return false
+}
+
+func (x *Explanation) GetResult() EvalResult {
+	if x != nil {
+		return x.Result
+	}
+	return EvalResult_ALLOWED
+}
+
+type PermissionLevelExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubjectDistance int32                    `protobuf:"varint,1,opt,name=subjectDistance,proto3" json:"subjectDistance,omitempty"`
+	ObjectDistance  int32                    `protobuf:"varint,2,opt,name=objectDistance,proto3" json:"objectDistance,omitempty"`
+	Permissions     []*PermissionExplanation `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Result          EvalResult               `protobuf:"varint,4,opt,name=result,proto3,enum=proto.EvalResult" json:"result,omitempty"`
+}
+
+func (x *PermissionLevelExplanation) Reset() {
+	*x = PermissionLevelExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionLevelExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionLevelExplanation) ProtoMessage() {}
+
+func (x *PermissionLevelExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionLevelExplanation.ProtoReflect.Descriptor instead.
+func (*PermissionLevelExplanation) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PermissionLevelExplanation) GetSubjectDistance() int32 {
+	if x != nil {
+		return x.SubjectDistance
+	}
+	return 0
+}
+
+func (x *PermissionLevelExplanation) GetObjectDistance() int32 {
+	if x != nil {
+		return x.ObjectDistance
+	}
+	return 0
+}
+
+func (x *PermissionLevelExplanation) GetPermissions() []*PermissionExplanation {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *PermissionLevelExplanation) GetResult() EvalResult {
+	if x != nil {
+		return x.Result
+	}
+	return EvalResult_ALLOWED
+}
+
+type PermissionExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Permission   *Permission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	SubjectScope *Resource   `protobuf:"bytes,2,opt,name=subjectScope,proto3" json:"subjectScope,omitempty"`
+	ObjectScope  *Resource   `protobuf:"bytes,3,opt,name=objectScope,proto3" json:"objectScope,omitempty"`
+	ConditionMet bool        `protobuf:"varint,4,opt,name=conditionMet,proto3" json:"conditionMet,omitempty"`
+	Result       EvalResult  `protobuf:"varint,5,opt,name=result,proto3,enum=proto.EvalResult" json:"result,omitempty"`
+}
+
+func (x *PermissionExplanation) Reset() {
+	*x = PermissionExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionExplanation) ProtoMessage() {}
+
+func (x *PermissionExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionExplanation.ProtoReflect.Descriptor instead.
+func (*PermissionExplanation) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PermissionExplanation) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+func (x *PermissionExplanation) GetSubjectScope() *Resource {
+	if x != nil {
+		return x.SubjectScope
+	}
+	return nil
+}
+
+func (x *PermissionExplanation) GetObjectScope() *Resource {
+	if x != nil {
+		return x.ObjectScope
+	}
+	return nil
+}
+
+func (x *PermissionExplanation) GetConditionMet() bool {
+	if x != nil {
+		return x.ConditionMet
+	}
+	return false
+}
+
+func (x *PermissionExplanation) GetResult() EvalResult {
+	if x != nil {
+		return x.Result
+	}
+	return EvalResult_ALLOWED
+}
+
+type GetGrantedPermissionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject       *Resource    `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	EnvAttributes []*Attribute `protobuf:"bytes,2,rep,name=envAttributes,proto3" json:"envAttributes,omitempty"`
+}
+
+func (x *GetGrantedPermissionsReq) Reset() {
+	*x = GetGrantedPermissionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGrantedPermissionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGrantedPermissionsReq) ProtoMessage() {}
+
+func (x *GetGrantedPermissionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGrantedPermissionsReq.ProtoReflect.Descriptor instead.
+func (*GetGrantedPermissionsReq) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGrantedPermissionsReq) GetSubject() *Resource {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *GetGrantedPermissionsReq) GetEnvAttributes() []*Attribute {
+	if x != nil {
+		return x.EnvAttributes
+	}
+	return nil
+}
+
+type GetGrantedPermissionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Permissions []*GrantedPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (x *AuthorizationReq) Reset() {
-	*x = AuthorizationReq{}
+func (x *GetGrantedPermissionsResp) Reset() {
+	*x = GetGrantedPermissionsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_evaluator_proto_msgTypes[0]
+		mi := &file_evaluator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizationReq) String() string {
+func (x *GetGrantedPermissionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationReq) ProtoMessage() {}
+func (*GetGrantedPermissionsResp) ProtoMessage() {}
 
-func (x *AuthorizationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_evaluator_proto_msgTypes[0]
+func (x *GetGrantedPermissionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,64 +682,133 @@ func (x *AuthorizationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationReq.ProtoReflect.Descriptor instead.
-func (*AuthorizationReq) Descriptor() ([]byte, []int) {
-	return file_evaluator_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetGrantedPermissionsResp.ProtoReflect.Descriptor instead.
+func (*GetGrantedPermissionsResp) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AuthorizationReq) GetSubject() *Resource {
+func (x *GetGrantedPermissionsResp) GetPermissions() []*GrantedPermission {
 	if x != nil {
-		return x.Subject
+		return x.Permissions
 	}
 	return nil
 }
 
-func (x *AuthorizationReq) GetObject() *Resource {
+type GetAuthorizedSubjectsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Object         *Resource    `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	PermissionName string       `protobuf:"bytes,2,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	EnvAttributes  []*Attribute `protobuf:"bytes,3,rep,name=envAttributes,proto3" json:"envAttributes,omitempty"`
+	// if set, only subjects of this kind are returned
+	SubjectKind string `protobuf:"bytes,4,opt,name=subjectKind,proto3" json:"subjectKind,omitempty"`
+	PageSize    int32  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken   string `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *GetAuthorizedSubjectsReq) Reset() {
+	*x = GetAuthorizedSubjectsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuthorizedSubjectsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorizedSubjectsReq) ProtoMessage() {}
+
+func (x *GetAuthorizedSubjectsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorizedSubjectsReq.ProtoReflect.Descriptor instead.
+func (*GetAuthorizedSubjectsReq) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAuthorizedSubjectsReq) GetObject() *Resource {
 	if x != nil {
 		return x.Object
 	}
 	return nil
 }
 
-func (x *AuthorizationReq) GetEnvAttributes() []*Attribute {
+func (x *GetAuthorizedSubjectsReq) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *GetAuthorizedSubjectsReq) GetEnvAttributes() []*Attribute {
 	if x != nil {
 		return x.EnvAttributes
 	}
 	return nil
 }
 
-func (x *AuthorizationReq) GetPermissionName() string {
+func (x *GetAuthorizedSubjectsReq) GetSubjectKind() string {
 	if x != nil {
-		return x.PermissionName
+		return x.SubjectKind
 	}
 	return ""
 }
 
-type AuthorizationResp struct {
+func (x *GetAuthorizedSubjectsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAuthorizedSubjectsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetAuthorizedSubjectsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Subjects []*Resource `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// empty if there are no more subjects, pages can be short or empty while it is set
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *AuthorizationResp) Reset() {
-	*x = AuthorizationResp{}
+func (x *GetAuthorizedSubjectsResp) Reset() {
+	*x = GetAuthorizedSubjectsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_evaluator_proto_msgTypes[1]
+		mi := &file_evaluator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizationResp) String() string {
+func (x *GetAuthorizedSubjectsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationResp) ProtoMessage() {}
+func (*GetAuthorizedSubjectsResp) ProtoMessage() {}
 
-func (x *AuthorizationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_evaluator_proto_msgTypes[1]
+func (x *GetAuthorizedSubjectsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,44 +819,56 @@ func (x *AuthorizationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationResp.ProtoReflect.Descriptor instead.
-func (*AuthorizationResp) Descriptor() ([]byte, []int) {
-	return file_evaluator_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetAuthorizedSubjectsResp.ProtoReflect.Descriptor instead.
+func (*GetAuthorizedSubjectsResp) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AuthorizationResp) GetAuthorized() bool {
+func (x *GetAuthorizedSubjectsResp) GetSubjects() []*Resource {
 	if x != nil {
-		return x.Authorized
+		return x.Subjects
 	}
-	return false
+	return nil
 }
 
-type GetGrantedPermissionsReq struct {
+func (x *GetAuthorizedSubjectsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListAccessibleObjectsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subject       *Resource    `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	EnvAttributes []*Attribute `protobuf:"bytes,2,rep,name=envAttributes,proto3" json:"envAttributes,omitempty"`
+	Subject        *Resource    `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	PermissionName string       `protobuf:"bytes,2,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	EnvAttributes  []*Attribute `protobuf:"bytes,3,rep,name=envAttributes,proto3" json:"envAttributes,omitempty"`
+	// if set, only objects of this kind are returned
+	ObjectKind string `protobuf:"bytes,4,opt,name=objectKind,proto3" json:"objectKind,omitempty"`
+	PageSize   int32  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken  string `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 }
 
-func (x *GetGrantedPermissionsReq) Reset() {
-	*x = GetGrantedPermissionsReq{}
+func (x *ListAccessibleObjectsReq) Reset() {
+	*x = ListAccessibleObjectsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_evaluator_proto_msgTypes[2]
+		mi := &file_evaluator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGrantedPermissionsReq) String() string {
+func (x *ListAccessibleObjectsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGrantedPermissionsReq) ProtoMessage() {}
+func (*ListAccessibleObjectsReq) ProtoMessage() {}
 
-func (x *GetGrantedPermissionsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_evaluator_proto_msgTypes[2]
+func (x *ListAccessibleObjectsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,50 +879,80 @@ func (x *GetGrantedPermissionsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGrantedPermissionsReq.ProtoReflect.Descriptor instead.
-func (*GetGrantedPermissionsReq) Descriptor() ([]byte, []int) {
-	return file_evaluator_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListAccessibleObjectsReq.ProtoReflect.Descriptor instead.
+func (*ListAccessibleObjectsReq) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetGrantedPermissionsReq) GetSubject() *Resource {
+func (x *ListAccessibleObjectsReq) GetSubject() *Resource {
 	if x != nil {
 		return x.Subject
 	}
 	return nil
 }
 
-func (x *GetGrantedPermissionsReq) GetEnvAttributes() []*Attribute {
+func (x *ListAccessibleObjectsReq) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *ListAccessibleObjectsReq) GetEnvAttributes() []*Attribute {
 	if x != nil {
 		return x.EnvAttributes
 	}
 	return nil
 }
 
-type GetGrantedPermissionsResp struct {
+func (x *ListAccessibleObjectsReq) GetObjectKind() string {
+	if x != nil {
+		return x.ObjectKind
+	}
+	return ""
+}
+
+func (x *ListAccessibleObjectsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccessibleObjectsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAccessibleObjectsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Permissions []*GrantedPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Objects []*Resource `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	// empty if there are no more objects, pages can be short or empty while it is set
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *GetGrantedPermissionsResp) Reset() {
-	*x = GetGrantedPermissionsResp{}
+func (x *ListAccessibleObjectsResp) Reset() {
+	*x = ListAccessibleObjectsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_evaluator_proto_msgTypes[3]
+		mi := &file_evaluator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGrantedPermissionsResp) String() string {
+func (x *ListAccessibleObjectsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGrantedPermissionsResp) ProtoMessage() {}
+func (*ListAccessibleObjectsResp) ProtoMessage() {}
 
-func (x *GetGrantedPermissionsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_evaluator_proto_msgTypes[3]
+func (x *ListAccessibleObjectsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,24 +963,31 @@ func (x *GetGrantedPermissionsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGrantedPermissionsResp.ProtoReflect.Descriptor instead.
-func (*GetGrantedPermissionsResp) Descriptor() ([]byte, []int) {
-	return file_evaluator_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListAccessibleObjectsResp.ProtoReflect.Descriptor instead.
+func (*ListAccessibleObjectsResp) Descriptor() ([]byte, []int) {
+	return file_evaluator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetGrantedPermissionsResp) GetPermissions() []*GrantedPermission {
+func (x *ListAccessibleObjectsResp) GetObjects() []*Resource {
 	if x != nil {
-		return x.Permissions
+		return x.Objects
 	}
 	return nil
 }
 
+func (x *ListAccessibleObjectsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_evaluator_proto protoreflect.FileDescriptor
 
 var file_evaluator_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
 	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x75,
 	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x75,
@@ -257,11 +999,79 @@ var file_evaluator_proto_rawDesc = []byte{
 	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x41, 0x74, 0x74, 0x72,
 	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x33,
-	0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x22, 0x69, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x06,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x4e, 0x0a,
+	0x16, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xb1, 0x01,
+	0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x69, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x1a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x81, 0x02, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x31, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x7d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
 	0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12,
 	0x29, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
@@ -274,21 +1084,87 @@ var file_evaluator_proto_rawDesc = []byte{
 	0x3a, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61,
 	0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xaf, 0x01, 0x0a, 0x0d,
-	0x4f, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a,
-	0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x1e, 0x5a,
-	0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73,
-	0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x65, 0x6e, 0x76,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xff, 0x01,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x0d, 0x65, 0x6e, 0x76, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b,
+	0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x6c, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x07,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x39, 0x0a,
+	0x0a, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4e, 0x49,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x41, 0x4c,
+	0x55, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x32, 0xbc, 0x03, 0x0a, 0x0d, 0x4f, 0x6f, 0x72,
+	0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -303,32 +1179,71 @@ func file_evaluator_proto_rawDescGZIP() []byte {
 	return file_evaluator_proto_rawDescData
 }
 
-var file_evaluator_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_evaluator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evaluator_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_evaluator_proto_goTypes = []interface{}{
-	(*AuthorizationReq)(nil),          // 0: proto.AuthorizationReq
-	(*AuthorizationResp)(nil),         // 1: proto.AuthorizationResp
-	(*GetGrantedPermissionsReq)(nil),  // 2: proto.GetGrantedPermissionsReq
-	(*GetGrantedPermissionsResp)(nil), // 3: proto.GetGrantedPermissionsResp
-	(*Resource)(nil),                  // 4: proto.Resource
-	(*Attribute)(nil),                 // 5: proto.Attribute
-	(*GrantedPermission)(nil),         // 6: proto.GrantedPermission
+	(EvalResult)(0),                    // 0: proto.EvalResult
+	(*AuthorizationReq)(nil),           // 1: proto.AuthorizationReq
+	(*AuthorizationResp)(nil),          // 2: proto.AuthorizationResp
+	(*AuthorizationBatchReq)(nil),      // 3: proto.AuthorizationBatchReq
+	(*AuthorizationBatchResp)(nil),     // 4: proto.AuthorizationBatchResp
+	(*AuthorizationResult)(nil),        // 5: proto.AuthorizationResult
+	(*Explanation)(nil),                // 6: proto.Explanation
+	(*PermissionLevelExplanation)(nil), // 7: proto.PermissionLevelExplanation
+	(*PermissionExplanation)(nil),      // 8: proto.PermissionExplanation
+	(*GetGrantedPermissionsReq)(nil),   // 9: proto.GetGrantedPermissionsReq
+	(*GetGrantedPermissionsResp)(nil),  // 10: proto.GetGrantedPermissionsResp
+	(*GetAuthorizedSubjectsReq)(nil),   // 11: proto.GetAuthorizedSubjectsReq
+	(*GetAuthorizedSubjectsResp)(nil),  // 12: proto.GetAuthorizedSubjectsResp
+	(*ListAccessibleObjectsReq)(nil),   // 13: proto.ListAccessibleObjectsReq
+	(*ListAccessibleObjectsResp)(nil),  // 14: proto.ListAccessibleObjectsResp
+	(*Resource)(nil),                   // 15: proto.Resource
+	(*Attribute)(nil),                  // 16: proto.Attribute
+	(ErrorCode)(0),                     // 17: proto.ErrorCode
+	(*Permission)(nil),                 // 18: proto.Permission
+	(*GrantedPermission)(nil),          // 19: proto.GrantedPermission
 }
 var file_evaluator_proto_depIdxs = []int32{
-	4, // 0: proto.AuthorizationReq.subject:type_name -> proto.Resource
-	4, // 1: proto.AuthorizationReq.object:type_name -> proto.Resource
-	5, // 2: proto.AuthorizationReq.envAttributes:type_name -> proto.Attribute
-	4, // 3: proto.GetGrantedPermissionsReq.subject:type_name -> proto.Resource
-	5, // 4: proto.GetGrantedPermissionsReq.envAttributes:type_name -> proto.Attribute
-	6, // 5: proto.GetGrantedPermissionsResp.permissions:type_name -> proto.GrantedPermission
-	0, // 6: proto.OortEvaluator.Authorize:input_type -> proto.AuthorizationReq
-	2, // 7: proto.OortEvaluator.GetGrantedPermissions:input_type -> proto.GetGrantedPermissionsReq
-	1, // 8: proto.OortEvaluator.Authorize:output_type -> proto.AuthorizationResp
-	3, // 9: proto.OortEvaluator.GetGrantedPermissions:output_type -> proto.GetGrantedPermissionsResp
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	15, // 0: proto.AuthorizationReq.subject:type_name -> proto.Resource
+	15, // 1: proto.AuthorizationReq.object:type_name -> proto.Resource
+	16, // 2: proto.AuthorizationReq.envAttributes:type_name -> proto.Attribute
+	6,  // 3: proto.AuthorizationResp.explanation:type_name -> proto.Explanation
+	1,  // 4: proto.AuthorizationBatchReq.checks:type_name -> proto.AuthorizationReq
+	5,  // 5: proto.AuthorizationBatchResp.results:type_name -> proto.AuthorizationResult
+	6,  // 6: proto.AuthorizationResult.explanation:type_name -> proto.Explanation
+	17, // 7: proto.AuthorizationResult.errorCode:type_name -> proto.ErrorCode
+	7,  // 8: proto.Explanation.levels:type_name -> proto.PermissionLevelExplanation
+	0,  // 9: proto.Explanation.result:type_name -> proto.EvalResult
+	8,  // 10: proto.PermissionLevelExplanation.permissions:type_name -> proto.PermissionExplanation
+	0,  // 11: proto.PermissionLevelExplanation.result:type_name -> proto.EvalResult
+	18, // 12: proto.PermissionExplanation.permission:type_name -> proto.Permission
+	15, // 13: proto.PermissionExplanation.subjectScope:type_name -> proto.Resource
+	15, // 14: proto.PermissionExplanation.objectScope:type_name -> proto.Resource
+	0,  // 15: proto.PermissionExplanation.result:type_name -> proto.EvalResult
+	15, // 16: proto.GetGrantedPermissionsReq.subject:type_name -> proto.Resource
+	16, // 17: proto.GetGrantedPermissionsReq.envAttributes:type_name -> proto.Attribute
+	19, // 18: proto.GetGrantedPermissionsResp.permissions:type_name -> proto.GrantedPermission
+	15, // 19: proto.GetAuthorizedSubjectsReq.object:type_name -> proto.Resource
+	16, // 20: proto.GetAuthorizedSubjectsReq.envAttributes:type_name -> proto.Attribute
+	15, // 21: proto.GetAuthorizedSubjectsResp.subjects:type_name -> proto.Resource
+	15, // 22: proto.ListAccessibleObjectsReq.subject:type_name -> proto.Resource
+	16, // 23: proto.ListAccessibleObjectsReq.envAttributes:type_name -> proto.Attribute
+	15, // 24: proto.ListAccessibleObjectsResp.objects:type_name -> proto.Resource
+	1,  // 25: proto.OortEvaluator.Authorize:input_type -> proto.AuthorizationReq
+	3,  // 26: proto.OortEvaluator.AuthorizeBatch:input_type -> proto.AuthorizationBatchReq
+	9,  // 27: proto.OortEvaluator.GetGrantedPermissions:input_type -> proto.GetGrantedPermissionsReq
+	11, // 28: proto.OortEvaluator.GetAuthorizedSubjects:input_type -> proto.GetAuthorizedSubjectsReq
+	13, // 29: proto.OortEvaluator.ListAccessibleObjects:input_type -> proto.ListAccessibleObjectsReq
+	2,  // 30: proto.OortEvaluator.Authorize:output_type -> proto.AuthorizationResp
+	4,  // 31: proto.OortEvaluator.AuthorizeBatch:output_type -> proto.AuthorizationBatchResp
+	10, // 32: proto.OortEvaluator.GetGrantedPermissions:output_type -> proto.GetGrantedPermissionsResp
+	12, // 33: proto.OortEvaluator.GetAuthorizedSubjects:output_type -> proto.GetAuthorizedSubjectsResp
+	14, // 34: proto.OortEvaluator.ListAccessibleObjects:output_type -> proto.ListAccessibleObjectsResp
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_evaluator_proto_init() }
@@ -363,7 +1278,7 @@ func file_evaluator_proto_init() {
 			}
 		}
 		file_evaluator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGrantedPermissionsReq); i {
+			switch v := v.(*AuthorizationBatchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -375,6 +1290,78 @@ func file_evaluator_proto_init() {
 			}
 		}
 		file_evaluator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationBatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Explanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionLevelExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGrantedPermissionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGrantedPermissionsResp); i {
 			case 0:
 				return &v.state
@@ -386,19 +1373,68 @@ func file_evaluator_proto_init() {
 				return nil
 			}
 		}
+		file_evaluator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorizedSubjectsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorizedSubjectsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccessibleObjectsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccessibleObjectsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evaluator_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_evaluator_proto_goTypes,
 		DependencyIndexes: file_evaluator_proto_depIdxs,
+		EnumInfos:         file_evaluator_proto_enumTypes,
 		MessageInfos:      file_evaluator_proto_msgTypes,
 	}.Build()
 	File_evaluator_proto = out.File
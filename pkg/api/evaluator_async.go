@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/c12s/oort/pkg/messaging"
+	"github.com/c12s/oort/pkg/messaging/nats"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nuid"
+	"google.golang.org/protobuf/proto"
+)
+
+type EvaluationAsyncClient struct {
+	conn       *natsgo.Conn
+	publisher  messaging.Publisher
+	subscriber messaging.Subscriber
+	// all responses are received on this subject and matched to requests by correlation id
+	replySubject string
+	pending      *pendingReplies[*EvaluationAsyncResp]
+}
+
+// NewEvaluationAsyncClient connects to NATS with the options, as NewAdministrationAsyncClient does
+func NewEvaluationAsyncClient(natsAddress string, options ...natsgo.Option) (*EvaluationAsyncClient, error) {
+	conn, err := natsgo.Connect(fmt.Sprintf("nats://%s", natsAddress), options...)
+	if err != nil {
+		return nil, err
+	}
+	publisher, err := nats.NewPublisher(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	replySubject := publisher.GenerateReplySubject()
+	subscriber, err := nats.NewSubscriber(conn, replySubject, "")
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	client := &EvaluationAsyncClient{
+		conn:         conn,
+		publisher:    publisher,
+		subscriber:   subscriber,
+		replySubject: replySubject,
+		pending:      newPendingReplies[*EvaluationAsyncResp](),
+	}
+	err = subscriber.Subscribe(client.receive)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (n *EvaluationAsyncClient) Authorize(ctx context.Context, req *AuthorizationReq) (*AuthorizationResp, error) {
+	resp := &AuthorizationResp{}
+	err := n.request(ctx, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (n *EvaluationAsyncClient) GetGrantedPermissions(ctx context.Context, req *GetGrantedPermissionsReq) (*GetGrantedPermissionsResp, error) {
+	resp := &GetGrantedPermissionsResp{}
+	err := n.request(ctx, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Close stops receiving responses and closes the connection
+func (n *EvaluationAsyncClient) Close() error {
+	err := n.subscriber.Unsubscribe()
+	n.conn.Close()
+	return err
+}
+
+// request sends the request and unmarshals the response into resp, returning an *AsyncError if the request failed
+func (n *EvaluationAsyncClient) request(ctx context.Context, req EvaluationReq, resp proto.Message) error {
+	reqMarshalled, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	evalReq := &EvaluationAsyncReq{
+		Kind:          req.Kind(),
+		ReqMarshalled: reqMarshalled,
+		CorrelationId: nuid.Next(),
+	}
+	evalReqMarshalled, err := evalReq.Marshal()
+	if err != nil {
+		return err
+	}
+
+	respCh := n.pending.register(evalReq.CorrelationId)
+	err = n.publisher.Request(evalReqMarshalled, AuthorizationReqSubject, n.replySubject, nil)
+	if err != nil {
+		n.pending.forget(evalReq.CorrelationId)
+		return err
+	}
+	evalResp, err := n.pending.wait(ctx, evalReq.CorrelationId, respCh)
+	if err != nil {
+		return err
+	}
+	if evalResp.ErrorCode != ErrorCode_OK {
+		return &AsyncError{
+			Code:    evalResp.ErrorCode,
+			Message: evalResp.Error,
+		}
+	}
+	return proto.Unmarshal(evalResp.RespMarshalled, resp)
+}
+
+func (n *EvaluationAsyncClient) receive(msg []byte, _ string, _ messaging.Header) error {
+	resp := &EvaluationAsyncResp{}
+	err := resp.Unmarshal(msg)
+	if err != nil {
+		return err
+	}
+	if !n.pending.deliver(resp.CorrelationId, resp) {
+		log.Printf("no pending request with correlation id %q", resp.CorrelationId)
+	}
+	return nil
+}
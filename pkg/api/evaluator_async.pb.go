@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: evaluator_async.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EvaluationAsyncReq_ReqKind int32
+
+const (
+	EvaluationAsyncReq_Authorize             EvaluationAsyncReq_ReqKind = 0
+	EvaluationAsyncReq_GetGrantedPermissions EvaluationAsyncReq_ReqKind = 1
+)
+
+// Enum value maps for EvaluationAsyncReq_ReqKind.
+var (
+	EvaluationAsyncReq_ReqKind_name = map[int32]string{
+		0: "Authorize",
+		1: "GetGrantedPermissions",
+	}
+	EvaluationAsyncReq_ReqKind_value = map[string]int32{
+		"Authorize":             0,
+		"GetGrantedPermissions": 1,
+	}
+)
+
+func (x EvaluationAsyncReq_ReqKind) Enum() *EvaluationAsyncReq_ReqKind {
+	p := new(EvaluationAsyncReq_ReqKind)
+	*p = x
+	return p
+}
+
+func (x EvaluationAsyncReq_ReqKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluationAsyncReq_ReqKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_evaluator_async_proto_enumTypes[0].Descriptor()
+}
+
+func (EvaluationAsyncReq_ReqKind) Type() protoreflect.EnumType {
+	return &file_evaluator_async_proto_enumTypes[0]
+}
+
+func (x EvaluationAsyncReq_ReqKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluationAsyncReq_ReqKind.Descriptor instead.
+func (EvaluationAsyncReq_ReqKind) EnumDescriptor() ([]byte, []int) {
+	return file_evaluator_async_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type EvaluationAsyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind          EvaluationAsyncReq_ReqKind `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.EvaluationAsyncReq_ReqKind" json:"kind,omitempty"`
+	ReqMarshalled []byte                     `protobuf:"bytes,2,opt,name=reqMarshalled,proto3" json:"reqMarshalled,omitempty"`
+	// echoed in the response
+	CorrelationId string `protobuf:"bytes,3,opt,name=correlationId,proto3" json:"correlationId,omitempty"`
+}
+
+func (x *EvaluationAsyncReq) Reset() {
+	*x = EvaluationAsyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_async_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationAsyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationAsyncReq) ProtoMessage() {}
+
+func (x *EvaluationAsyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_async_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationAsyncReq.ProtoReflect.Descriptor instead.
+func (*EvaluationAsyncReq) Descriptor() ([]byte, []int) {
+	return file_evaluator_async_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EvaluationAsyncReq) GetKind() EvaluationAsyncReq_ReqKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EvaluationAsyncReq_Authorize
+}
+
+func (x *EvaluationAsyncReq) GetReqMarshalled() []byte {
+	if x != nil {
+		return x.ReqMarshalled
+	}
+	return nil
+}
+
+func (x *EvaluationAsyncReq) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type EvaluationAsyncResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// marshalled response matching the request kind, empty if the request failed
+	RespMarshalled []byte    `protobuf:"bytes,1,opt,name=respMarshalled,proto3" json:"respMarshalled,omitempty"`
+	ErrorCode      ErrorCode `protobuf:"varint,2,opt,name=errorCode,proto3,enum=proto.ErrorCode" json:"errorCode,omitempty"`
+	Error          string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// correlation id of the request, empty if the request couldn't be read
+	CorrelationId string `protobuf:"bytes,4,opt,name=correlationId,proto3" json:"correlationId,omitempty"`
+}
+
+func (x *EvaluationAsyncResp) Reset() {
+	*x = EvaluationAsyncResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluator_async_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationAsyncResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationAsyncResp) ProtoMessage() {}
+
+func (x *EvaluationAsyncResp) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluator_async_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationAsyncResp.ProtoReflect.Descriptor instead.
+func (*EvaluationAsyncResp) Descriptor() ([]byte, []int) {
+	return file_evaluator_async_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EvaluationAsyncResp) GetRespMarshalled() []byte {
+	if x != nil {
+		return x.RespMarshalled
+	}
+	return nil
+}
+
+func (x *EvaluationAsyncResp) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_OK
+}
+
+func (x *EvaluationAsyncResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EvaluationAsyncResp) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+var File_evaluator_async_proto protoreflect.FileDescriptor
+
+var file_evaluator_async_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x12,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x12, 0x35, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x2e, 0x52, 0x65, 0x71, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71,
+	0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x72, 0x65, 0x71, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70,
+	0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_evaluator_async_proto_rawDescOnce sync.Once
+	file_evaluator_async_proto_rawDescData = file_evaluator_async_proto_rawDesc
+)
+
+func file_evaluator_async_proto_rawDescGZIP() []byte {
+	file_evaluator_async_proto_rawDescOnce.Do(func() {
+		file_evaluator_async_proto_rawDescData = protoimpl.X.CompressGZIP(file_evaluator_async_proto_rawDescData)
+	})
+	return file_evaluator_async_proto_rawDescData
+}
+
+var file_evaluator_async_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evaluator_async_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_evaluator_async_proto_goTypes = []interface{}{
+	(EvaluationAsyncReq_ReqKind)(0), // 0: proto.EvaluationAsyncReq.ReqKind
+	(*EvaluationAsyncReq)(nil),      // 1: proto.EvaluationAsyncReq
+	(*EvaluationAsyncResp)(nil),     // 2: proto.EvaluationAsyncResp
+	(ErrorCode)(0),                  // 3: proto.ErrorCode
+}
+var file_evaluator_async_proto_depIdxs = []int32{
+	0, // 0: proto.EvaluationAsyncReq.kind:type_name -> proto.EvaluationAsyncReq.ReqKind
+	3, // 1: proto.EvaluationAsyncResp.errorCode:type_name -> proto.ErrorCode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_evaluator_async_proto_init() }
+func file_evaluator_async_proto_init() {
+	if File_evaluator_async_proto != nil {
+		return
+	}
+	file_model_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_evaluator_async_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationAsyncReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluator_async_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationAsyncResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_evaluator_async_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_evaluator_async_proto_goTypes,
+		DependencyIndexes: file_evaluator_async_proto_depIdxs,
+		EnumInfos:         file_evaluator_async_proto_enumTypes,
+		MessageInfos:      file_evaluator_async_proto_msgTypes,
+	}.Build()
+	File_evaluator_async_proto = out.File
+	file_evaluator_async_proto_rawDesc = nil
+	file_evaluator_async_proto_goTypes = nil
+	file_evaluator_async_proto_depIdxs = nil
+}
@@ -23,7 +23,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OortEvaluatorClient interface {
 	Authorize(ctx context.Context, in *AuthorizationReq, opts ...grpc.CallOption) (*AuthorizationResp, error)
+	AuthorizeBatch(ctx context.Context, in *AuthorizationBatchReq, opts ...grpc.CallOption) (*AuthorizationBatchResp, error)
 	GetGrantedPermissions(ctx context.Context, in *GetGrantedPermissionsReq, opts ...grpc.CallOption) (*GetGrantedPermissionsResp, error)
+	GetAuthorizedSubjects(ctx context.Context, in *GetAuthorizedSubjectsReq, opts ...grpc.CallOption) (*GetAuthorizedSubjectsResp, error)
+	ListAccessibleObjects(ctx context.Context, in *ListAccessibleObjectsReq, opts ...grpc.CallOption) (*ListAccessibleObjectsResp, error)
 }
 
 type oortEvaluatorClient struct {
@@ -43,6 +46,15 @@ func (c *oortEvaluatorClient) Authorize(ctx context.Context, in *AuthorizationRe
 	return out, nil
 }
 
+func (c *oortEvaluatorClient) AuthorizeBatch(ctx context.Context, in *AuthorizationBatchReq, opts ...grpc.CallOption) (*AuthorizationBatchResp, error) {
+	out := new(AuthorizationBatchResp)
+	err := c.cc.Invoke(ctx, "/proto.OortEvaluator/AuthorizeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oortEvaluatorClient) GetGrantedPermissions(ctx context.Context, in *GetGrantedPermissionsReq, opts ...grpc.CallOption) (*GetGrantedPermissionsResp, error) {
 	out := new(GetGrantedPermissionsResp)
 	err := c.cc.Invoke(ctx, "/proto.OortEvaluator/GetGrantedPermissions", in, out, opts...)
@@ -52,12 +64,33 @@ func (c *oortEvaluatorClient) GetGrantedPermissions(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *oortEvaluatorClient) GetAuthorizedSubjects(ctx context.Context, in *GetAuthorizedSubjectsReq, opts ...grpc.CallOption) (*GetAuthorizedSubjectsResp, error) {
+	out := new(GetAuthorizedSubjectsResp)
+	err := c.cc.Invoke(ctx, "/proto.OortEvaluator/GetAuthorizedSubjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortEvaluatorClient) ListAccessibleObjects(ctx context.Context, in *ListAccessibleObjectsReq, opts ...grpc.CallOption) (*ListAccessibleObjectsResp, error) {
+	out := new(ListAccessibleObjectsResp)
+	err := c.cc.Invoke(ctx, "/proto.OortEvaluator/ListAccessibleObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OortEvaluatorServer is the server API for OortEvaluator service.
 // All implementations must embed UnimplementedOortEvaluatorServer
 // for forward compatibility
 type OortEvaluatorServer interface {
 	Authorize(context.Context, *AuthorizationReq) (*AuthorizationResp, error)
+	AuthorizeBatch(context.Context, *AuthorizationBatchReq) (*AuthorizationBatchResp, error)
 	GetGrantedPermissions(context.Context, *GetGrantedPermissionsReq) (*GetGrantedPermissionsResp, error)
+	GetAuthorizedSubjects(context.Context, *GetAuthorizedSubjectsReq) (*GetAuthorizedSubjectsResp, error)
+	ListAccessibleObjects(context.Context, *ListAccessibleObjectsReq) (*ListAccessibleObjectsResp, error)
 	mustEmbedUnimplementedOortEvaluatorServer()
 }
 
@@ -68,9 +101,18 @@ type UnimplementedOortEvaluatorServer struct {
 func (UnimplementedOortEvaluatorServer) Authorize(context.Context, *AuthorizationReq) (*AuthorizationResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
 }
+func (UnimplementedOortEvaluatorServer) AuthorizeBatch(context.Context, *AuthorizationBatchReq) (*AuthorizationBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeBatch not implemented")
+}
 func (UnimplementedOortEvaluatorServer) GetGrantedPermissions(context.Context, *GetGrantedPermissionsReq) (*GetGrantedPermissionsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGrantedPermissions not implemented")
 }
+func (UnimplementedOortEvaluatorServer) GetAuthorizedSubjects(context.Context, *GetAuthorizedSubjectsReq) (*GetAuthorizedSubjectsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorizedSubjects not implemented")
+}
+func (UnimplementedOortEvaluatorServer) ListAccessibleObjects(context.Context, *ListAccessibleObjectsReq) (*ListAccessibleObjectsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessibleObjects not implemented")
+}
 func (UnimplementedOortEvaluatorServer) mustEmbedUnimplementedOortEvaluatorServer() {}
 
 // UnsafeOortEvaluatorServer may be embedded to opt out of forward compatibility for this service.
@@ -102,6 +144,24 @@ func _OortEvaluator_Authorize_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OortEvaluator_AuthorizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizationBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortEvaluatorServer).AuthorizeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortEvaluator/AuthorizeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortEvaluatorServer).AuthorizeBatch(ctx, req.(*AuthorizationBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OortEvaluator_GetGrantedPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGrantedPermissionsReq)
 	if err := dec(in); err != nil {
@@ -120,6 +180,42 @@ func _OortEvaluator_GetGrantedPermissions_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OortEvaluator_GetAuthorizedSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorizedSubjectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortEvaluatorServer).GetAuthorizedSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortEvaluator/GetAuthorizedSubjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortEvaluatorServer).GetAuthorizedSubjects(ctx, req.(*GetAuthorizedSubjectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortEvaluator_ListAccessibleObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessibleObjectsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortEvaluatorServer).ListAccessibleObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortEvaluator/ListAccessibleObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortEvaluatorServer).ListAccessibleObjects(ctx, req.(*ListAccessibleObjectsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OortEvaluator_ServiceDesc is the grpc.ServiceDesc for OortEvaluator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -131,10 +227,22 @@ var OortEvaluator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authorize",
 			Handler:    _OortEvaluator_Authorize_Handler,
 		},
+		{
+			MethodName: "AuthorizeBatch",
+			Handler:    _OortEvaluator_AuthorizeBatch_Handler,
+		},
 		{
 			MethodName: "GetGrantedPermissions",
 			Handler:    _OortEvaluator_GetGrantedPermissions_Handler,
 		},
+		{
+			MethodName: "GetAuthorizedSubjects",
+			Handler:    _OortEvaluator_GetAuthorizedSubjects_Handler,
+		},
+		{
+			MethodName: "ListAccessibleObjects",
+			Handler:    _OortEvaluator_ListAccessibleObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evaluator.proto",
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+type EvaluationReq interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+	Kind() EvaluationAsyncReq_ReqKind
+}
+
+func (x *AuthorizationReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *AuthorizationReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *AuthorizationReq) Kind() EvaluationAsyncReq_ReqKind {
+	return EvaluationAsyncReq_Authorize
+}
+
+func (x *GetGrantedPermissionsReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *GetGrantedPermissionsReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *GetGrantedPermissionsReq) Kind() EvaluationAsyncReq_ReqKind {
+	return EvaluationAsyncReq_GetGrantedPermissions
+}
+
+func (x *EvaluationAsyncReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *EvaluationAsyncReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *EvaluationAsyncResp) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *EvaluationAsyncResp) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
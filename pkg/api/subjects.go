@@ -1,5 +1,16 @@
 package api
 
+import "fmt"
+
 const (
 	AdministrationReqSubject = "oort.administration"
+	AuthorizationReqSubject  = "oort.authorization"
+	EventsSubjectPrefix      = "oort.events"
+	DecisionsSubject         = "oort.decisions"
 )
+
+// EventSubject returns the subject events about changes of an entity are published on,
+// e.g. oort.events.policy.created. Use * or > to subscribe to several of them.
+func EventSubject(entity, action string) string {
+	return fmt.Sprintf("%s.%s.%s", EventsSubjectPrefix, entity, action)
+}
@@ -1,12 +1,30 @@
 package messaging
 
+import "errors"
+
+// ErrInvalidMessage is returned by handlers for messages that can never be handled,
+// so that subscribers that redeliver failed messages don't retry them
+var ErrInvalidMessage = errors.New("invalid message")
+
+// LastDeliveryHeader is set by subscribers that redeliver failed messages when the message won't be
+// delivered again, so that handlers can reply with the error instead of leaving the requester waiting
+const LastDeliveryHeader = "Oort-Last-Delivery"
+
+// Header holds the headers of a message, keyed by header name
+type Header map[string]string
+
+// Handler handles a message. Subscribers that support acknowledgements
+// consider the message handled only if the handler returns no error.
+type Handler func(msg []byte, replySubject string, header Header) error
+
 type Subscriber interface {
-	Subscribe(handler func(msg []byte, replySubject string)) error
+	Subscribe(handler Handler) error
 	Unsubscribe() error
 }
 
 type Publisher interface {
 	Publish(msg []byte, subject string) error
-	Request(msg []byte, subject, replySubject string) error
+	// Request publishes a message whose response is expected on replySubject, header may be nil
+	Request(msg []byte, subject, replySubject string, header Header) error
 	GenerateReplySubject() string
 }
@@ -0,0 +1,240 @@
+package nats
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/c12s/oort/pkg/messaging"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	DeadLetterErrorHeader   = "Oort-Error"
+	DeadLetterSubjectHeader = "Oort-Subject"
+)
+
+type JetStreamConfig struct {
+	// stream that captures the subject, created if it doesn't exist
+	Stream string
+	// durable consumer shared by all subscribers in the queue group, created if it doesn't exist
+	Durable string
+	// number of times a message is delivered before it is dead-lettered
+	MaxDeliver int
+	// delays before redeliveries, the last one is used for all remaining ones
+	BackOff []time.Duration
+	// subject that receives messages that can't be handled
+	DeadLetterSubject string
+	// request headers copied to dead-lettered messages besides the reply subject,
+	// others are dropped so that credentials they carry aren't persisted
+	DeadLetterHeaders []string
+	// time the server waits for an acknowledgement before redelivering, the subscriber
+	// reports progress while a handler runs so that long handlers aren't redelivered
+	AckWait time.Duration
+	// messages are removed once acknowledged, those that aren't are removed after MaxAge
+	MaxAge time.Duration
+}
+
+// the server's default
+const defaultAckWait = 30 * time.Second
+
+type jetStreamSubscriber struct {
+	conn         *nats.Conn
+	js           nats.JetStreamContext
+	subscription *nats.Subscription
+	subject      string
+	queue        string
+	config       JetStreamConfig
+}
+
+// NewJetStreamSubscriber returns a subscriber that acknowledges messages only after they have been handled.
+// Failed messages are redelivered with backoff, and dead-lettered if they are invalid or keep failing.
+func NewJetStreamSubscriber(conn *nats.Conn, subject, queue string, config JetStreamConfig) (messaging.Subscriber, error) {
+	if conn == nil {
+		return nil, errors.New("conn nil")
+	}
+	if config.Stream == "" || config.Durable == "" {
+		return nil, errors.New("stream and durable consumer names are required")
+	}
+	if config.MaxDeliver <= 0 {
+		return nil, errors.New("max deliver must be positive")
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	return &jetStreamSubscriber{
+		conn:    conn,
+		js:      js,
+		subject: subject,
+		queue:   queue,
+		config:  config,
+	}, nil
+}
+
+func (s *jetStreamSubscriber) Subscribe(handler messaging.Handler) error {
+	if s.subscription != nil {
+		return errors.New("already subscribed")
+	}
+	err := s.ensureStream()
+	if err != nil {
+		return err
+	}
+	err = s.ensureConsumer()
+	if err != nil {
+		return err
+	}
+	// the consumer is bound rather than created by the subscription,
+	// so that unsubscribing doesn't delete it
+	subscription, err := s.js.QueueSubscribe(s.subject, s.queue, func(msg *nats.Msg) {
+		s.handle(msg, handler)
+	}, nats.Bind(s.config.Stream, s.config.Durable), nats.ManualAck())
+	if err != nil {
+		return err
+	}
+	s.subscription = subscription
+	return nil
+}
+
+func (s *jetStreamSubscriber) handle(msg *nats.Msg, handler messaging.Handler) {
+	delivered := uint64(1)
+	if metadata, err := msg.Metadata(); err == nil {
+		delivered = metadata.NumDelivered
+	}
+	lastDelivery := delivered >= uint64(s.config.MaxDeliver)
+	msgHeader := header(msg)
+	// the header is only trusted when the subscriber sets it
+	delete(msgHeader, messaging.LastDeliveryHeader)
+	if lastDelivery {
+		msgHeader[messaging.LastDeliveryHeader] = "true"
+	}
+
+	stopProgress := s.reportProgress(msg)
+	handlerErr := handler(msg.Data, replySubject(msg), msgHeader)
+	stopProgress()
+	if handlerErr == nil {
+		if err := msg.Ack(); err != nil {
+			log.Println(err)
+		}
+		return
+	}
+	log.Println(handlerErr)
+
+	if errors.Is(handlerErr, messaging.ErrInvalidMessage) || lastDelivery {
+		s.deadLetter(msg, handlerErr)
+		return
+	}
+	if err := msg.NakWithDelay(s.backOff(delivered)); err != nil {
+		log.Println(err)
+	}
+}
+
+// reportProgress resets the ack wait of the message periodically until the returned function is called
+func (s *jetStreamSubscriber) reportProgress(msg *nats.Msg) func() {
+	ackWait := s.config.AckWait
+	if ackWait <= 0 {
+		ackWait = defaultAckWait
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(ackWait / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := msg.InProgress(); err != nil {
+					log.Println(err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func (s *jetStreamSubscriber) deadLetter(msg *nats.Msg, handlerErr error) {
+	if s.config.DeadLetterSubject != "" {
+		err := s.conn.PublishMsg(&nats.Msg{
+			Subject: s.config.DeadLetterSubject,
+			Header:  s.deadLetterHeader(msg, handlerErr),
+			Data:    msg.Data,
+		})
+		if err != nil {
+			// leave the message to be redelivered after the ack wait
+			log.Println(err)
+			return
+		}
+	}
+	if err := msg.Term(); err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *jetStreamSubscriber) deadLetterHeader(msg *nats.Msg, handlerErr error) nats.Header {
+	header := nats.Header{}
+	for _, key := range append([]string{ReplySubjectHeader}, s.config.DeadLetterHeaders...) {
+		if values := msg.Header.Values(key); len(values) > 0 {
+			header[key] = values
+		}
+	}
+	header.Set(DeadLetterErrorHeader, handlerErr.Error())
+	header.Set(DeadLetterSubjectHeader, msg.Subject)
+	return header
+}
+
+func (s *jetStreamSubscriber) backOff(delivered uint64) time.Duration {
+	if len(s.config.BackOff) == 0 {
+		return 0
+	}
+	i := int(delivered) - 1
+	if i >= len(s.config.BackOff) {
+		i = len(s.config.BackOff) - 1
+	}
+	return s.config.BackOff[i]
+}
+
+func (s *jetStreamSubscriber) ensureStream() error {
+	_, err := s.js.StreamInfo(s.config.Stream)
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+	// the retention policy of an existing stream can't be changed, it has to be recreated
+	_, err = s.js.AddStream(&nats.StreamConfig{
+		Name:      s.config.Stream,
+		Subjects:  []string{s.subject},
+		Storage:   nats.FileStorage,
+		Retention: nats.WorkQueuePolicy,
+		MaxAge:    s.config.MaxAge,
+	})
+	return err
+}
+
+func (s *jetStreamSubscriber) ensureConsumer() error {
+	_, err := s.js.ConsumerInfo(s.config.Stream, s.config.Durable)
+	if !errors.Is(err, nats.ErrConsumerNotFound) {
+		return err
+	}
+	_, err = s.js.AddConsumer(s.config.Stream, &nats.ConsumerConfig{
+		Durable:        s.config.Durable,
+		DeliverSubject: nats.NewInbox(),
+		DeliverGroup:   s.queue,
+		FilterSubject:  s.subject,
+		AckPolicy:      nats.AckExplicitPolicy,
+		AckWait:        s.config.AckWait,
+		MaxDeliver:     s.config.MaxDeliver,
+	})
+	return err
+}
+
+func (s *jetStreamSubscriber) Unsubscribe() error {
+	if s.subscription != nil && s.subscription.IsValid() {
+		return s.subscription.Drain()
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package nats
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+)
+
+// the header names pkg/api uses, it can't be imported since it depends on this package
+const (
+	testActorHeader         = "Oort-Actor"
+	testAuthorizationHeader = "Oort-Authorization"
+)
+
+func TestDeadLetterHeaderDropsCredentials(t *testing.T) {
+	subscriber := &jetStreamSubscriber{
+		config: JetStreamConfig{DeadLetterHeaders: []string{testActorHeader}},
+	}
+	msg := &nats.Msg{
+		Subject: "requests",
+		Header: nats.Header{
+			ReplySubjectHeader:      []string{"replies"},
+			testActorHeader:         []string{"admin"},
+			testAuthorizationHeader: []string{"Bearer secret"},
+			"Other":                 []string{"value"},
+		},
+	}
+	header := subscriber.deadLetterHeader(msg, errors.New("failed"))
+
+	expected := map[string]string{
+		ReplySubjectHeader:      "replies",
+		testActorHeader:         "admin",
+		DeadLetterErrorHeader:   "failed",
+		DeadLetterSubjectHeader: "requests",
+	}
+	if len(header) != len(expected) {
+		t.Errorf("expected headers %v, got %v", expected, header)
+	}
+	for key, value := range expected {
+		if header.Get(key) != value {
+			t.Errorf("expected header %s to be %q, got %q", key, value, header.Get(key))
+		}
+	}
+	if _, ok := header[testAuthorizationHeader]; ok {
+		t.Error("expected the dead-lettered message to carry no token")
+	}
+}
@@ -7,6 +7,10 @@ import (
 	"github.com/nats-io/nats.go"
 )
 
+// ReplySubjectHeader carries the reply subject of requests. The reply subject of the message
+// itself is left empty, as JetStream would use it to acknowledge the publish.
+const ReplySubjectHeader = "Oort-Reply-To"
+
 type publisher struct {
 	conn *nats.Conn
 }
@@ -24,8 +28,17 @@ func (p publisher) Publish(msg []byte, subject string) error {
 	return p.conn.Publish(subject, msg)
 }
 
-func (p publisher) Request(msg []byte, subject, replySubject string) error {
-	return p.conn.PublishRequest(subject, replySubject, msg)
+func (p publisher) Request(msg []byte, subject, replySubject string, header messaging.Header) error {
+	natsHeader := nats.Header{}
+	for key, value := range header {
+		natsHeader.Set(key, value)
+	}
+	natsHeader.Set(ReplySubjectHeader, replySubject)
+	return p.conn.PublishMsg(&nats.Msg{
+		Subject: subject,
+		Header:  natsHeader,
+		Data:    msg,
+	})
 }
 
 func (p publisher) GenerateReplySubject() string {
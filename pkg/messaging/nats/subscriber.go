@@ -2,6 +2,8 @@ package nats
 
 import (
 	"errors"
+	"log"
+
 	"github.com/c12s/oort/pkg/messaging"
 	"github.com/nats-io/nats.go"
 )
@@ -24,12 +26,15 @@ func NewSubscriber(conn *nats.Conn, subject, queue string) (messaging.Subscriber
 	}, nil
 }
 
-func (s *subscriber) Subscribe(handler func(msg []byte, replySubject string)) error {
+func (s *subscriber) Subscribe(handler messaging.Handler) error {
 	if s.subscription != nil {
 		return errors.New("already subscribed")
 	}
 	subscription, err := s.conn.QueueSubscribe(s.subject, s.queue, func(msg *nats.Msg) {
-		handler(msg.Data, msg.Reply)
+		err := handler(msg.Data, replySubject(msg), header(msg))
+		if err != nil {
+			log.Println(err)
+		}
 	})
 	if err != nil {
 		return err
@@ -44,3 +49,21 @@ func (s *subscriber) Unsubscribe() error {
 	}
 	return nil
 }
+
+// replySubject prefers the reply subject header, since messages stored in
+// a stream lose their reply subject
+func replySubject(msg *nats.Msg) string {
+	if reply := msg.Header.Get(ReplySubjectHeader); reply != "" {
+		return reply
+	}
+	return msg.Reply
+}
+
+// header keeps the first value of every header
+func header(msg *nats.Msg) messaging.Header {
+	header := make(messaging.Header, len(msg.Header))
+	for key := range msg.Header {
+		header[key] = msg.Header.Get(key)
+	}
+	return header
+}